@@ -0,0 +1,157 @@
+package main
+
+import (
+	"fmt"
+
+	htmlColor "madcolor/htmlcolor"
+)
+
+// The request and result types of the server (madcolor serve) and RPC
+// (madcolor rpc) modes, and the functions that compute the results, so
+// that both answer in the same shape.
+
+// colorizeRequest is the body of POST /colorize: the text, and the
+// colorize options under their flag names. Options that are left out
+// take their command-line defaults.
+type colorizeRequest struct {
+	Text string `json:"text"`
+	colorOptions
+}
+
+// colorUse is one foreground color used in a colorize response. An
+// invented color has no name, so it carries its nearest named color.
+type colorUse struct {
+	Hex     string `json:"hex"`
+	Name    string `json:"name,omitempty"`
+	Nearest string `json:"nearest,omitempty"`
+	Count   int    `json:"count"`
+}
+
+// colorizeResponse is the answer to POST /colorize.
+type colorizeResponse struct {
+	Output     string     `json:"output"`
+	Format     string     `json:"format"`
+	Background string     `json:"background-color,omitempty"`
+	Units      int        `json:"units"`
+	Colors     []colorUse `json:"colors"`
+}
+
+// contrastResponse is the answer to GET /contrast. Distance and
+// Contrast are the measures colorize uses for --distance and --contrast;
+// the others are the standard ones.
+type contrastResponse struct {
+	Foreground  string          `json:"fg"`
+	Background  string          `json:"bg"`
+	Distance    float64         `json:"distance"`
+	Contrast    float64         `json:"contrast"`
+	Ratio       float64         `json:"ratio"`
+	WCAG        map[string]bool `json:"wcag"`
+	APCA        float64         `json:"apca"`
+	DeltaE      float64         `json:"delta-e"`
+	FgLuminance float64         `json:"fg-luminance"`
+	BgLuminance float64         `json:"bg-luminance"`
+}
+
+// nearestResponse is the nearest named color to a color. Distance is
+// the perceptual distance, ΔE (see htmlColor.DeltaE).
+type nearestResponse struct {
+	Color    string  `json:"color"`
+	Name     string  `json:"name"`
+	Hex      string  `json:"hex"`
+	Distance float64 `json:"distance"`
+}
+
+// colorizeResult runs the colorize pipeline for a request that has
+// already been validated. Its errors are those of colorizeText.
+func colorizeResult(req colorizeRequest) (resp colorizeResponse, err error) {
+	out, runs, bg, err := colorizeText(req.Text, req.colorOptions)
+	if nil != err {
+		return resp, err
+	}
+	return colorizeResponse{
+		Output:     out,
+		Format:     req.Format,
+		Background: bg,
+		Units:      len(runs),
+		Colors:     colorsUsed(runs),
+	}, nil
+}
+
+// contrastResult compares two colors given in any syntax
+// --background-color accepts. An unknown color is an error wrapping
+// htmlColor.ErrUnknownColor.
+func contrastResult(fgIn string, bgIn string) (resp contrastResponse, err error) {
+	fg, err := htmlColor.ParseColor(fgIn)
+	if nil != err {
+		return resp, fmt.Errorf("fg: %w", err)
+	}
+	bg, err := htmlColor.ParseColor(bgIn)
+	if nil != err {
+		return resp, fmt.Errorf("bg: %w", err)
+	}
+	resp = contrastResponse{
+		Foreground: fg,
+		Background: bg,
+		WCAG:       make(map[string]bool, len(htmlColor.WCAGLevels)),
+	}
+	resp.Distance, resp.Contrast, err = htmlColor.ColorDistance(fg, bg)
+	if nil == err {
+		resp.Ratio, err = htmlColor.ContrastRatio(fg, bg)
+	}
+	if nil == err {
+		resp.APCA, err = htmlColor.APCA(fg, bg)
+	}
+	if nil == err {
+		resp.DeltaE, err = htmlColor.DeltaE(fg, bg)
+	}
+	if nil == err {
+		resp.FgLuminance, err = htmlColor.Luminance(fg)
+	}
+	if nil == err {
+		resp.BgLuminance, err = htmlColor.Luminance(bg)
+	}
+	if nil != err {
+		return contrastResponse{}, err
+	}
+	for _, level := range htmlColor.WCAGLevels {
+		resp.WCAG[level.Name] = resp.Ratio >= level.Ratio
+	}
+	return resp, nil
+}
+
+// nearestResult finds the named color nearest to a color given in any
+// syntax --background-color accepts. An unknown color is an error
+// wrapping htmlColor.ErrUnknownColor.
+func nearestResult(in string) (resp nearestResponse, err error) {
+	hex, err := htmlColor.ParseColor(in)
+	if nil != err {
+		return resp, err
+	}
+	name, nearest, dist, err := htmlColor.NearestColor(hex)
+	if nil != err {
+		return resp, err
+	}
+	return nearestResponse{Color: hex, Name: name, Hex: nearest, Distance: dist}, nil
+}
+
+// colorsUsed lists the distinct foreground colors of runs, in the order
+// they first appear, with how often each was used.
+func colorsUsed(runs []coloredRun) []colorUse {
+	used := make([]colorUse, 0, len(runs))
+	index := make(map[string]int, len(runs))
+	for _, run := range runs {
+		ix, ok := index[run.fg]
+		if !ok {
+			ix = len(used)
+			index[run.fg] = ix
+			use := colorUse{Hex: run.fg, Name: run.fgName}
+			if "" == use.Name && "" != use.Hex {
+				// run.fg was picked as "#rrggbb"
+				use.Nearest, _, _, _ = htmlColor.NearestColor(run.fg)
+			}
+			used = append(used, use)
+		}
+		used[ix].Count++
+	}
+	return used
+}
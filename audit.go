@@ -0,0 +1,319 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"html"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	htmlColor "madcolor/htmlcolor"
+	"madcolor/misc"
+)
+
+var FlagAuditBackground string
+var FlagAuditContrast string
+var FlagAuditFix bool
+var FlagAuditOutput string
+var FlagAuditJSON bool
+
+// auditExcerpt is the most text of a failing run an audit report shows.
+const auditExcerpt = 24
+
+// auditFinding is one run of text that fails the contrast level, or
+// whose color could not be read (then Unparsed holds the value, and
+// there is no ratio).
+type auditFinding struct {
+	File       string  `json:"file"`
+	Line       int     `json:"line"`
+	Column     int     `json:"column"`
+	Text       string  `json:"text"`
+	Foreground string  `json:"fg"`
+	Background string  `json:"bg"`
+	Ratio      float64 `json:"ratio"`
+	Level      string  `json:"level"`
+	Fix        string  `json:"fix,omitempty"`
+	Unparsed   string  `json:"unparsed,omitempty"`
+}
+
+// colorEdit replaces the bytes from start to end of a file with hex.
+type colorEdit struct {
+	start int
+	end   int
+	hex   string
+}
+
+// newAuditFlags returns the flag set of the audit command.
+func newAuditFlags() *commandFlags {
+	aFlags := newCommandFlags("audit")
+
+	aFlags.StringVarP(&FlagAuditBackground, "background", "b", "white",
+		"Background assumed where the markup sets none")
+	aFlags.StringVarP(&FlagAuditContrast, "contrast", "c", "AA",
+		"Contrast level to audit for: a WCAG level (AA, AA-large, AAA, AAA-large) or a ratio such as 4.5")
+	aFlags.BoolVarP(&FlagAuditFix, "fix", "", false,
+		"Replace each failing color with the closest color that passes")
+	aFlags.StringVarP(&FlagAuditOutput, "output", "o", "",
+		"With --fix, write the fixed markup here (- for stdout) rather than over the input")
+	aFlags.BoolVarP(&FlagAuditJSON, "json", "j", false,
+		"Write the findings as JSON")
+	completeFlag(aFlags.FlagSet, "background", completeColor)
+	completeFlag(aFlags.FlagSet, "contrast", completeChoice, wcagLevelNames()...)
+	completeFlag(aFlags.FlagSet, "output", completeFile)
+	return aFlags
+}
+
+// runAudit implements `madcolor audit <file.html> ...`: it reports each
+// run of text whose color fails a contrast level against its
+// background, and with --fix, rewrites the failing colors. It returns
+// an ErrCheckFailed when a finding is left unfixed.
+func runAudit(args []string) error {
+	aFlags := newAuditFlags()
+
+	args, err := parseCommandFlags(aFlags, args)
+	if nil == err {
+		err = requireArgs(aFlags, args, 1, -1)
+	}
+	if nil != err {
+		return err
+	}
+
+	level, ratio, err := parseContrastLevel(FlagAuditContrast)
+	if nil != err {
+		return usageErrorf("--contrast: %w", err)
+	}
+	background, err := htmlColor.ParseColor(FlagAuditBackground)
+	if nil != err {
+		return usageErrorf("--background: %w", err)
+	}
+	if misc.IsStringSet(&FlagAuditOutput) && 1 < len(args) {
+		return usageErrorf("--output takes one input file, not %d", len(args))
+	}
+
+	var findings []auditFinding
+	unfixed := 0
+	for _, file := range args {
+		markup, err := readMarkup(file)
+		if nil != err {
+			return fmt.Errorf("%w %s: %w", ErrInput, file, err)
+		}
+		found, edits := auditMarkup(file, string(markup), background, level, ratio)
+		findings = append(findings, found...)
+		for _, f := range found {
+			if "" == f.Fix {
+				unfixed++
+			}
+		}
+		if FlagAuditFix && 0 < len(edits) {
+			err = writeFixed(file, applyColorEdits(string(markup), edits))
+			if nil != err {
+				return err
+			}
+		}
+	}
+
+	if FlagAuditJSON {
+		if nil == findings {
+			findings = []auditFinding{}
+		}
+		err = printJSON(findings)
+	} else {
+		err = printFindings(findings)
+	}
+	xLog.Info("audited", "failing", len(findings), "level", level, "fixed", len(findings)-unfixed)
+	if nil == err && 0 < unfixed {
+		err = fmt.Errorf("%w: %d run(s) of text fail %s or cannot be checked", ErrCheckFailed, unfixed, level)
+	}
+	return err
+}
+
+// parseContrastLevel parses a contrast level given as a WCAG level name
+// or as a ratio ("4.5" or "4.5:1"), returning its name and ratio.
+func parseContrastLevel(s string) (name string, ratio float64, err error) {
+	level, ok := htmlColor.ParseWCAGLevel(s)
+	if ok {
+		return level.Name, level.Ratio, nil
+	}
+	_, err = fmt.Sscanf(strings.TrimSuffix(strings.TrimSpace(s), ":1"), "%g", &ratio)
+	if nil != err || ratio < 1 || ratio > 21 {
+		return "", 0, fmt.Errorf("a contrast level must be AA, AA-large, AAA, AAA-large or a ratio from 1 to 21, not %q", s)
+	}
+	return fmt.Sprintf("%g:1", ratio), ratio, nil
+}
+
+// readMarkup reads a file, or stdin for "-".
+func readMarkup(file string) ([]byte, error) {
+	if "-" == file {
+		return io.ReadAll(os.Stdin)
+	}
+	return os.ReadFile(file)
+}
+
+// auditMarkup finds the runs of text in markup whose color has less than
+// ratio contrast against their background (background where the markup
+// sets none). Text without a color of its own, and whitespace, are not
+// checked. Text whose color or background is a value styleTexts cannot
+// resolve is reported with Unparsed set, since it cannot be said to
+// pass. With --fix, it returns the edits that replace each failing
+// color with the closest one that passes; findings that could not be
+// fixed have no Fix. One color declaration may cover text on several
+// backgrounds (nested spans), so its fix must pass on all of them.
+func auditMarkup(file string, markup string, background string,
+	level string, ratio float64) (findings []auditFinding, edits []colorEdit) {
+	texts := styleTexts(markup, tokenizeMarkup(markup))
+	fixed := make(map[int]string) // by fgPos[0]
+	backgrounds := make(map[int][]string)
+	for _, st := range texts {
+		if "" != st.fg && "" == st.bgUnparsed {
+			bg := st.bg
+			if "" == bg {
+				bg = background
+			}
+			backgrounds[st.fgPos[0]] = append(backgrounds[st.fgPos[0]], bg)
+		}
+	}
+
+	for _, st := range texts {
+		text := html.UnescapeString(markup[st.tok.start:st.tok.end])
+		unparsed := st.fgUnparsed
+		if "" == unparsed {
+			unparsed = st.bgUnparsed
+		}
+		if ("" == st.fg && "" == st.fgUnparsed) || "" == strings.TrimSpace(text) {
+			continue
+		}
+		bg := st.bg
+		if "" == bg {
+			bg = background
+		}
+
+		lead := len(text) - len(strings.TrimLeft(text, " \t\n\r\f"))
+		line, col := lineCol(markup, st.tok.start+lead)
+		excerpt := []rune(strings.TrimSpace(text))
+		if len(excerpt) > auditExcerpt {
+			excerpt = append(excerpt[:auditExcerpt-1], '…')
+		}
+		f := auditFinding{File: file, Line: line, Column: col, Text: string(excerpt),
+			Foreground: st.fg, Background: bg, Level: level}
+		if "" != unparsed {
+			f.Unparsed = unparsed
+			findings = append(findings, f)
+			continue
+		}
+
+		// the colors of styleTexts, and background, are "#rrggbb"
+		r, err := htmlColor.ContrastRatio(st.fg, bg)
+		if nil != err || r >= ratio {
+			continue
+		}
+		f.Ratio = r
+
+		if FlagAuditFix {
+			hex, done := fixed[st.fgPos[0]]
+			if !done {
+				hex = fixAgainst(st.fg, backgrounds[st.fgPos[0]], ratio)
+				if "" != hex {
+					edits = append(edits, colorEdit{start: st.fgPos[0], end: st.fgPos[1], hex: hex})
+				}
+				fixed[st.fgPos[0]] = hex
+			}
+			f.Fix = hex
+		}
+		findings = append(findings, f)
+	}
+	return findings, edits
+}
+
+// fixAgainst returns the closest color to fg that has ratio contrast
+// against each of backgrounds, or "" if there is none. The fix for each
+// background in turn is tried against all of them.
+func fixAgainst(fg string, backgrounds []string, ratio float64) string {
+	for _, bg := range backgrounds {
+		hex, ok, err := htmlColor.FixContrast(fg, bg, ratio)
+		if nil != err || !ok {
+			continue
+		}
+		passes := true
+		for _, other := range backgrounds {
+			r, err := htmlColor.ContrastRatio(hex, other)
+			if nil != err || r < ratio {
+				passes = false
+				break
+			}
+		}
+		if passes {
+			return hex
+		}
+	}
+	return ""
+}
+
+// applyColorEdits returns markup with the edits made.
+func applyColorEdits(markup string, edits []colorEdit) string {
+	var sb strings.Builder
+	sort.Slice(edits, func(i, j int) bool { return edits[i].start < edits[j].start })
+	pos := 0
+	for _, e := range edits {
+		sb.WriteString(markup[pos:e.start])
+		sb.WriteString(e.hex)
+		pos = e.end
+	}
+	sb.WriteString(markup[pos:])
+	return sb.String()
+}
+
+// writeFixed writes fixed markup for file: to --output if set, to
+// stdout for stdin, and otherwise over file itself.
+func writeFixed(file string, markup string) error {
+	var err error
+
+	target := file
+	if misc.IsStringSet(&FlagAuditOutput) {
+		target = FlagAuditOutput
+	}
+	if "-" == target {
+		_, err = os.Stdout.WriteString(markup)
+	} else {
+		mode := os.FileMode(0666)
+		if info, statErr := os.Stat(target); nil == statErr {
+			mode = info.Mode().Perm()
+		}
+		err = os.WriteFile(target, []byte(markup), mode)
+	}
+	if nil != err {
+		return fmt.Errorf("%w %s: %w", ErrOutput, target, err)
+	}
+	return nil
+}
+
+// printFindings writes one line per finding, as file:line:col, so that
+// editors can jump to them. With --fix on stdin the fixed markup goes to
+// stdout, so the report goes to stderr.
+func printFindings(findings []auditFinding) error {
+	var w io.Writer = os.Stdout
+	if FlagAuditFix && (FlagAuditOutput == "-" || (!misc.IsStringSet(&FlagAuditOutput) &&
+		0 < len(findings) && "-" == findings[0].File)) {
+		w = os.Stderr
+	}
+	out := bufio.NewWriter(w)
+
+	for _, f := range findings {
+		if "" != f.Unparsed {
+			_, _ = fmt.Fprintf(out, "%s:%d:%d: %q has color %q, which cannot be checked against %s\n",
+				f.File, f.Line, f.Column, f.Text, f.Unparsed, f.Level)
+			continue
+		}
+		_, _ = fmt.Fprintf(out, "%s:%d:%d: %q %s on %s is %.2f:1, fails %s",
+			f.File, f.Line, f.Column, f.Text, f.Foreground, f.Background, f.Ratio, f.Level)
+		switch {
+		case "" != f.Fix:
+			_, _ = fmt.Fprintf(out, "; fixed to %s", f.Fix)
+		case FlagAuditFix:
+			_, _ = out.WriteString("; no lightness of this color passes on all its backgrounds")
+		}
+		_, _ = out.WriteString("\n")
+	}
+	return flushOutput(out)
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"testing"
+
+	htmlColor "madcolor/htmlcolor"
+)
+
+// TestCSSColor checks the color values audit and recolor can resolve,
+// and that translucent and unknown ones are not taken for a color.
+func TestCSSColor(t *testing.T) {
+	cases := []struct {
+		value string
+		hex   string
+	}{
+		{"red", "#ff0000"},
+		{"#0f0", "#00ff00"},
+		{"#00f", "#0000ff"},
+		{"#ff000080", ""},
+		{"#f00f", "#ff0000"},
+		{"#336699ff", "#336699"},
+		{"rgb(255, 0, 0)", "#ff0000"},
+		{"RGB(0 128 255)", "#0080ff"},
+		{"rgb(100%, 50%, 0%)", "#ff8000"},
+		{"rgba(0, 0, 0, 1)", "#000000"},
+		{"rgb(0 0 0 / 100%)", "#000000"},
+		{"rgba(0, 0, 0, 0.5)", ""},
+		{"rgb(300, 0, 0)", ""},
+		{"rgb(1, 2)", ""},
+		{"hsl(120, 100%, 50%)", "#00ff00"},
+		{"hsl(240deg 100% 25%)", "#000080"},
+		{"hsla(0, 0%, 100%, 1)", "#ffffff"},
+		{"hsl(0, 0, 1)", ""},
+		{"var(--fg)", ""},
+	}
+	for _, c := range cases {
+		hex, known := cssColor(c.value)
+		if c.hex != hex || ("" != c.hex) != known {
+			t.Errorf("cssColor(%q) = %q, %v, want %q", c.value, hex, known, c.hex)
+		}
+	}
+}
+
+// TestAuditUnparsed checks that text whose color cannot be read is
+// reported rather than passed over, and that inherited and transparent
+// values are not.
+func TestAuditUnparsed(t *testing.T) {
+	markup := `<span style="color: rgb(255, 255, 255)">rgb</span>` +
+		`<span style="color: rgba(0, 0, 0, 0.5)">translucent</span>` +
+		`<span style="color: black; background: var(--bg)">var</span>` +
+		`<span style="color: black; background: url(x.png) no-repeat">image</span>` +
+		`<span style="color: var(--fg)"><span style="color: black">known</span></span>` +
+		`<span style="color: black; background-color: transparent">transparent</span>`
+
+	findings, _ := auditMarkup("page.html", markup, "#ffffff", "AA", 4.5)
+	want := map[string]string{
+		"rgb":         "",
+		"translucent": "rgba(0, 0, 0, 0.5)",
+		"var":         "var(--bg)",
+	}
+	for _, f := range findings {
+		unparsed, ok := want[f.Text]
+		if !ok || unparsed != f.Unparsed {
+			t.Errorf("unexpected finding %+v", f)
+		}
+		delete(want, f.Text)
+	}
+	for text := range want {
+		t.Errorf("no finding for %q", text)
+	}
+}
+
+// TestAuditFixNested checks that a color covering text on two
+// backgrounds is only fixed to a color that passes on both.
+func TestAuditFixNested(t *testing.T) {
+	FlagAuditFix = true
+	defer func() { FlagAuditFix = false }()
+
+	for _, inner := range []string{"#000000", "#111111"} {
+		markup := `<span style="color: #777777">light <span style="background-color: ` +
+			inner + `">dark</span></span>`
+		findings, edits := auditMarkup("page.html", markup, "#ffffff", "AA", 4.5)
+		if 0 == len(findings) {
+			t.Fatalf("%s: no findings", inner)
+		}
+		for _, f := range findings {
+			if "" == f.Fix {
+				continue
+			}
+			r, err := htmlColor.ContrastRatio(f.Fix, f.Background)
+			if nil != err || r < 4.5 {
+				t.Errorf("%s: %q fixed to %s, which is %.2f:1 on %s", inner, f.Text, f.Fix, r, f.Background)
+			}
+		}
+		if "#111111" == inner && 0 != len(edits) {
+			t.Errorf("no color passes on both white and %s, but it was fixed: %v", inner, edits)
+		}
+		if "#000000" == inner && 1 != len(edits) {
+			t.Errorf("a color passes on both white and %s, but it was not fixed", inner)
+		}
+	}
+}
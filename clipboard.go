@@ -0,0 +1,370 @@
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"golang.design/x/clipboard"
+	"madcolor/misc"
+)
+
+// clipFormat identifies one flavor of clipboard contents.
+type clipFormat int
+
+const (
+	clipText clipFormat = iota // text/plain
+	clipHTML                   // text/html
+)
+
+// String returns the MIME type of the format.
+func (f clipFormat) String() string {
+	if clipHTML == f {
+		return "text/html"
+	}
+	return "text/plain"
+}
+
+// errClipFormat is returned by a backend asked to read a flavor it
+// cannot provide.
+var errClipFormat = errors.New("clipboard format not supported by this backend")
+
+// clipboardBackend reads and writes the system clipboard (or a stand-in
+// for it). Backends that can hold several flavors at once (see
+// htmlClipboard) store both the text and the HTML; the others store the
+// text only, so that what is pasted is never lost on the applications
+// that only take plain text.
+type clipboardBackend interface {
+	// Name identifies the backend in log messages.
+	Name() string
+	// Read returns the clipboard contents in the given format.
+	Read(format clipFormat) ([]byte, error)
+	// Write places text, and html if it is not nil and the backend
+	// holds both flavors, on the clipboard.
+	Write(text []byte, html []byte) error
+}
+
+// htmlClipboard is implemented by backends that hold the text and the
+// HTML flavor at once, which --paste-html needs.
+type htmlClipboard interface {
+	HoldsHTML() bool
+}
+
+// holdsHTML reports whether backend keeps the HTML flavor it is given.
+func holdsHTML(backend clipboardBackend) bool {
+	h, ok := backend.(htmlClipboard)
+	return ok && h.HoldsHTML()
+}
+
+// clipBackend is the clipboard in use; see initializeClipboard.
+var clipBackend clipboardBackend
+
+// clipboard flavors accepted by --buff-format
+const (
+	clipFormatText = "text"
+	clipFormatHTML = "html"
+)
+
+/*****************************/
+
+// libClipboard uses golang.design/x/clipboard, which handles text/plain
+// only (and needs cgo and a display on Linux). It cannot write the HTML
+// flavor, so Write pastes the text and drops html; auto selection
+// therefore prefers the x11 backend, which can.
+type libClipboard struct{}
+
+func (libClipboard) Name() string { return "library" }
+
+func (libClipboard) Read(format clipFormat) ([]byte, error) {
+	if clipText != format {
+		return nil, errClipFormat
+	}
+	return clipboard.Read(clipboard.FmtText), nil
+}
+
+func (libClipboard) Write(text []byte, _ []byte) error {
+	// the library returns a nil channel when it could not take the
+	// clipboard
+	if nil == clipboard.Write(clipboard.FmtText, text) {
+		return errors.New("the clipboard library could not write the clipboard")
+	}
+	return nil
+}
+
+/*****************************/
+
+// commandClipboard runs an external tool for each clipboard access.
+// xclip and wl-copy serve only one flavor per invocation (a second
+// invocation replaces the first), so Write pastes the text and drops
+// html: written instead of the text, it would leave plain text editors
+// nothing to paste. Read can still get the text/html flavor that other
+// applications put on the clipboard.
+type commandClipboard struct {
+	name     string
+	textType string // the target under which the tool offers plain text
+	read     func(target string) []string
+	write    func(target string) []string
+}
+
+// xclipClipboard drives xclip on X11.
+var xclipClipboard = commandClipboard{
+	name:     "xclip",
+	textType: "UTF8_STRING",
+	read: func(target string) []string {
+		return []string{"xclip", "-selection", "clipboard", "-t", target, "-o"}
+	},
+	write: func(target string) []string {
+		return []string{"xclip", "-selection", "clipboard", "-t", target, "-i"}
+	},
+}
+
+// wlClipboard drives wl-copy and wl-paste on Wayland.
+var wlClipboard = commandClipboard{
+	name:     "wl-clipboard",
+	textType: "text/plain",
+	read: func(target string) []string {
+		return []string{"wl-paste", "--no-newline", "--type", target}
+	},
+	write: func(target string) []string {
+		return []string{"wl-copy", "--type", target}
+	},
+}
+
+func (c commandClipboard) Name() string { return c.name }
+
+// target returns the selection target to request for format.
+func (c commandClipboard) target(format clipFormat) string {
+	if clipHTML == format {
+		return clipHTML.String()
+	}
+	return c.textType
+}
+
+// available reports whether the tool is installed.
+func (c commandClipboard) available() bool {
+	_, err := exec.LookPath(c.write(c.textType)[0])
+	return nil == err
+}
+
+func (c commandClipboard) Read(format clipFormat) ([]byte, error) {
+	args := c.read(c.target(format))
+	out, err := exec.Command(args[0], args[1:]...).Output()
+	if nil != err {
+		return nil, fmt.Errorf("%s could not read %s because %w", args[0], format, err)
+	}
+	return out, nil
+}
+
+func (c commandClipboard) Write(text []byte, _ []byte) error {
+	args := c.write(c.textType)
+	// stdout and stderr are left unset (/dev/null): the tools fork a
+	// child that owns the selection, and a pipe would keep Run waiting
+	// until it exits.
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Stdin = bytes.NewReader(text)
+	err := cmd.Run()
+	if nil != err {
+		return fmt.Errorf("%s could not write %s because %w", args[0], clipText, err)
+	}
+	return nil
+}
+
+/*****************************/
+
+// osc52Clipboard writes the clipboard through the terminal, with the
+// OSC 52 escape sequence, which most terminal emulators honour even when
+// the program runs on a remote host over SSH. It is write-only (terminals
+// that answer clipboard queries at all are rare) and plain text only.
+type osc52Clipboard struct {
+	tty string
+}
+
+func (osc52Clipboard) Name() string { return "osc52" }
+
+func (osc52Clipboard) Read(_ clipFormat) ([]byte, error) {
+	return nil, errors.New("the osc52 clipboard cannot be read; use --text, --input or --pipe")
+}
+
+func (o osc52Clipboard) Write(text []byte, _ []byte) error {
+	var sb strings.Builder
+	tty, err := os.OpenFile(o.tty, os.O_WRONLY, 0)
+	if nil != err {
+		return fmt.Errorf("could not open %s for OSC 52 because %w", o.tty, err)
+	}
+	defer misc.DeferError(tty.Close)
+
+	seq := "\x1b]52;c;" + base64.StdEncoding.EncodeToString(text) + "\x07"
+	switch {
+	case "" != os.Getenv("TMUX"):
+		// tmux passes the sequence on if it is wrapped, and escapes doubled
+		sb.WriteString("\x1bPtmux;")
+		sb.WriteString(strings.ReplaceAll(seq, "\x1b", "\x1b\x1b"))
+		sb.WriteString("\x1b\\")
+	case strings.HasPrefix(os.Getenv("TERM"), "screen"):
+		sb.WriteString("\x1bP" + seq + "\x1b\\")
+	default:
+		sb.WriteString(seq)
+	}
+	_, err = tty.WriteString(sb.String())
+	return err
+}
+
+// ttyAvailable reports whether the controlling terminal can be opened.
+func (o osc52Clipboard) ttyAvailable() bool {
+	tty, err := os.OpenFile(o.tty, os.O_WRONLY, 0)
+	if nil != err {
+		return false
+	}
+	_ = tty.Close()
+	return true
+}
+
+/*****************************/
+
+// fileClipboard keeps the clipboard in files: the text flavor in path and
+// the HTML flavor in path + ".html". It stands in for a real clipboard in
+// tests and on headless machines, and holds both flavors at once.
+type fileClipboard struct {
+	path string
+}
+
+func (fileClipboard) Name() string { return "file" }
+
+// HoldsHTML reports that the file clipboard keeps both flavors.
+func (fileClipboard) HoldsHTML() bool { return true }
+
+// flavorPath returns the file holding format.
+func (f fileClipboard) flavorPath(format clipFormat) string {
+	if clipHTML == format {
+		return f.path + ".html"
+	}
+	return f.path
+}
+
+func (f fileClipboard) Read(format clipFormat) ([]byte, error) {
+	data, err := os.ReadFile(f.flavorPath(format))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, errClipFormat
+	}
+	return data, err
+}
+
+func (f fileClipboard) Write(text []byte, html []byte) error {
+	err := os.WriteFile(f.flavorPath(clipText), text, 0666)
+	if nil != err {
+		return err
+	}
+	if nil == html {
+		err = os.Remove(f.flavorPath(clipHTML))
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+	return os.WriteFile(f.flavorPath(clipHTML), html, 0666)
+}
+
+/*****************************/
+
+// clipboard backends accepted by --clipboard-backend
+const (
+	clipBackendAuto    = "auto"
+	clipBackendLibrary = "library"
+	clipBackendX11     = "x11"
+	clipBackendXclip   = "xclip"
+	clipBackendWayland = "wl-clipboard"
+	clipBackendOSC52   = "osc52"
+	clipBackendFile    = "file"
+)
+
+// clipBackendNames lists the --clipboard-backend values, for messages.
+var clipBackendNames = []string{clipBackendAuto, clipBackendX11, clipBackendLibrary, clipBackendXclip,
+	clipBackendWayland, clipBackendOSC52, clipBackendFile}
+
+// defaultClipboardFile is the --clipboard-file used by the file backend.
+func defaultClipboardFile() string {
+	return filepath.Join(os.TempDir(), "madcolor-clipboard.txt")
+}
+
+// selectClipboard returns the clipboard backend named by --clipboard-backend.
+// For auto, the x11 backend is preferred when the display accepts a
+// connection (also under Wayland, through Xwayland) because it alone
+// holds the text and the HTML flavor at once; then the command-line
+// tools; then the library; and last, on a terminal without a display
+// (typically over SSH), OSC 52.
+func selectClipboard(name string) (backend clipboardBackend, err error) {
+	osc52 := osc52Clipboard{tty: "/dev/tty"}
+	x11 := x11Clipboard{display: os.Getenv("DISPLAY")}
+
+	switch name {
+	case clipBackendX11:
+		if "" == x11.display {
+			return nil, errors.New("DISPLAY is not set")
+		}
+		err = x11.available()
+		if nil != err {
+			return nil, err
+		}
+		return x11, nil
+	case clipBackendLibrary:
+		err = clipboard.Init()
+		if nil != err {
+			return nil, err
+		}
+		return libClipboard{}, nil
+	case clipBackendXclip:
+		if !xclipClipboard.available() {
+			return nil, errors.New("xclip is not installed")
+		}
+		return xclipClipboard, nil
+	case clipBackendWayland:
+		if !wlClipboard.available() {
+			return nil, errors.New("wl-copy is not installed")
+		}
+		return wlClipboard, nil
+	case clipBackendOSC52:
+		return osc52, nil
+	case clipBackendFile:
+		return fileClipboard{path: FlagClipboardFile}, nil
+	}
+
+	if "" != x11.display && nil == x11.available() {
+		return x11, nil
+	}
+	if "" != os.Getenv("WAYLAND_DISPLAY") && wlClipboard.available() {
+		return wlClipboard, nil
+	}
+	if "" != os.Getenv("DISPLAY") && xclipClipboard.available() {
+		return xclipClipboard, nil
+	}
+	err = clipboard.Init()
+	if nil == err {
+		return libClipboard{}, nil
+	}
+	if osc52.ttyAvailable() {
+		return osc52, nil
+	}
+	return nil, err
+}
+
+// readClipboard returns the clipboard text to colorize. With
+// --buff-format html, the text/html flavor is preferred and its text
+// content extracted (tags dropped, entities decoded); if there is no
+// HTML on the clipboard, the plain text is used.
+func readClipboard(backend clipboardBackend) (data []byte, err error) {
+	if FlagBuffFormat == clipFormatHTML {
+		data, err = backend.Read(clipHTML)
+		if nil == err && len(data) > 0 {
+			return []byte(htmlText(string(data))), nil
+		}
+		xLog.Info("no text/html on the clipboard, reading text/plain",
+			"clipboard", backend.Name(), "err", err)
+	}
+	return backend.Read(clipText)
+}
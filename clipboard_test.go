@@ -0,0 +1,128 @@
+package main
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestFileClipboard checks that the file backend keeps both flavors, and
+// that writing text alone drops an earlier HTML flavor.
+func TestFileClipboard(t *testing.T) {
+	clip := fileClipboard{path: filepath.Join(t.TempDir(), "clipboard")}
+
+	err := clip.Write([]byte("text"), []byte("<b>html</b>"))
+	if nil != err {
+		t.Fatal(err)
+	}
+	for format, want := range map[clipFormat]string{clipText: "text", clipHTML: "<b>html</b>"} {
+		got, err := clip.Read(format)
+		if nil != err || want != string(got) {
+			t.Errorf("Read(%s) = %q, %v, want %q", format, got, err, want)
+		}
+	}
+
+	err = clip.Write([]byte("plain"), nil)
+	if nil != err {
+		t.Fatal(err)
+	}
+	if _, err := clip.Read(clipHTML); !errors.Is(err, errClipFormat) {
+		t.Errorf("the HTML flavor outlived a text-only write: %v", err)
+	}
+}
+
+// TestBuff colorizes the clipboard with --buff, from each flavor
+// --buff-format reads, and checks the clipboard is left as it was.
+func TestBuff(t *testing.T) {
+	cases := []struct {
+		name   string
+		format string
+		html   string // the HTML flavor, if any
+		want   string // the text that must be colorized
+	}{
+		{"text", clipFormatText, "<b>from html</b>", "from text"},
+		{"html", clipFormatHTML, "<b>from &amp; html</b>", "from & html"},
+		{"html missing", clipFormatHTML, "", "from text"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			dir := isolate(t)
+			clip := fileClipboard{path: filepath.Join(dir, "clipboard")}
+			var html []byte
+			if "" != c.html {
+				html = []byte(c.html)
+			}
+			err := clip.Write([]byte("from text"), html)
+			if nil != err {
+				t.Fatal(err)
+			}
+
+			err = run(t, "colorize", "--buff", "--buff-format", c.format,
+				"--clipboard-backend", "file", "--clipboard-file", clip.path,
+				"--output-dir", dir, "--output", "colored")
+			if nil != err {
+				t.Fatalf("colorize: %v", err)
+			}
+			err = run(t, "strip", "--output", filepath.Join(dir, "stripped"),
+				filepath.Join(dir, "colored"))
+			if nil != err {
+				t.Fatalf("strip: %v", err)
+			}
+			if got := readFile(t, filepath.Join(dir, "stripped")); c.want != got {
+				t.Errorf("colorized %q, want %q", got, c.want)
+			}
+			if got := readFile(t, clip.path); "from text" != got {
+				t.Errorf("--buff pasted back %q", got)
+			}
+		})
+	}
+}
+
+// TestPasteBack checks what is pasted to the clipboard: the output as
+// text, and as HTML too unless --paste-html is turned off, but never ANSI
+// output as HTML.
+func TestPasteBack(t *testing.T) {
+	cases := []struct {
+		name string
+		args []string
+		html bool
+	}{
+		{"default", nil, true},
+		{"no paste-html", []string{"--paste-html=false"}, false},
+		{"ansi", []string{"--format", "ansi"}, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			dir := isolate(t)
+			clip := fileClipboard{path: filepath.Join(dir, "clipboard")}
+			// a stale HTML flavor, which a text-only paste must drop
+			err := os.WriteFile(clip.flavorPath(clipHTML), []byte("stale"), 0666)
+			if nil != err {
+				t.Fatal(err)
+			}
+
+			args := append([]string{"colorize", "--text", "pasted", "--buff=false",
+				"--nopaste=true", "--clipboard-backend", "file", "--clipboard-file", clip.path,
+				"--output-dir", dir, "--output", "colored"}, c.args...)
+			err = run(t, args...)
+			if nil != err {
+				t.Fatalf("colorize: %v", err)
+			}
+
+			colored := readFile(t, filepath.Join(dir, "colored"))
+			if got := readFile(t, clip.path); colored != got {
+				t.Errorf("pasted text %q, want the output %q", got, colored)
+			}
+			html, err := clip.Read(clipHTML)
+			switch {
+			case c.html && (nil != err || colored != string(html)):
+				t.Errorf("pasted HTML %q, %v, want the output %q", html, err, colored)
+			case !c.html && !errors.Is(err, errClipFormat):
+				t.Errorf("pasted HTML %q, want none", html)
+			}
+		})
+	}
+}
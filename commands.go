@@ -0,0 +1,211 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/pflag"
+)
+
+// command is one subcommand of madcolor, such as `madcolor palette`.
+// run receives the arguments that follow the command name, and returns
+// the error main turns into the exit code (see exitCode). flags, if
+// not nil, returns the command's flag set without running it (for
+// shell completion).
+type command struct {
+	name    string
+	usage   string
+	summary string
+	run     func(args []string) error
+	flags   func() *commandFlags
+}
+
+// commands lists the subcommands, in the order `madcolor help` shows them.
+// It is filled in by init, since the help command refers to the list.
+var commands []command
+
+// defaultCommand runs when the first argument is not a command name, so
+// that plain `madcolor --text ...` keeps working.
+const defaultCommand = "colorize"
+
+func init() {
+	commands = []command{
+		{"colorize", "[flags]",
+			"Colorize text (the default command)", runColorize,
+			func() *commandFlags { return &commandFlags{newColorizeFlags(), "colorize"} }},
+		{"palette", "list|search [query]|export|lint [flags]",
+			"List, search, export or lint the named colors", runPalette, newPaletteFlags},
+		{"contrast", "<fg> <bg> [<fg> <bg> ...] [flags]",
+			"Check the WCAG and APCA contrast of color pairs", runContrast, newContrastFlags},
+		{"convert", "<color> [flags]",
+			"Convert a color name or hex value to other notations", runConvert, newConvertFlags},
+		{"audit", "<file.html> ... [flags]",
+			"Find text in HTML whose colors fail a contrast level", runAudit, newAuditFlags},
+		{"strip", "[file ...] [flags]",
+			"Recover the plain text from colorized output", runStrip, newStripFlags},
+		{"recolor", "[file] [flags]",
+			"Re-pick the colors of colorized HTML, keeping its text and spans", runRecolor, newRecolorFlags},
+		{"serve", "[flags]",
+			"Run a local HTTP server with a JSON colorize API", runServe, newServeFlags},
+		{"rpc", "",
+			"Run a JSON-RPC 2.0 server on stdin/stdout for editors", runRPC, newRPCFlags},
+		{"completion", "bash|zsh|fish",
+			"Write a shell completion script", runCompletion, newCompletionFlags},
+		{"help", "[command]",
+			"Show the commands, or the help for one command", runHelp, nil},
+	}
+}
+
+// findCommand returns the command called name.
+func findCommand(name string) (cmd command, ok bool) {
+	for _, cmd = range commands {
+		if cmd.name == name {
+			return cmd, true
+		}
+	}
+	return cmd, false
+}
+
+// runCommand dispatches the program arguments to a command, and
+// returns its error. Arguments that do not start with a command name go
+// to colorize, which has always ignored positional arguments (it warns
+// about them now, as they may be a misspelled command).
+func runCommand(args []string) error {
+	if 0 < len(args) && completeCommand == args[0] {
+		return runComplete(args[1:])
+	}
+	if 0 < len(args) && selectionCommand == args[0] {
+		return runSelectionOwner(args[1:])
+	}
+	if 0 < len(args) {
+		if cmd, ok := findCommand(args[0]); ok {
+			return cmd.run(args[1:])
+		}
+	}
+	cmd, _ := findCommand(defaultCommand)
+	return cmd.run(args)
+}
+
+// programName returns the name the program was invoked as.
+func programName() string {
+	_, name := filepath.Split(os.Args[0])
+	return name
+}
+
+// commandFlags is the flag set of a subcommand. pflag does not expose
+// the name a FlagSet was created with, so it is kept alongside.
+type commandFlags struct {
+	*pflag.FlagSet
+	name string
+}
+
+// newCommandFlags returns a flag set for a subcommand, with the flag name
+// normalization and the standard --help, --verbose, --debug, --quiet
+// and logging flags that every command shares.
+func newCommandFlags(name string) *commandFlags {
+	fs := &commandFlags{pflag.NewFlagSet(name, pflag.ContinueOnError), name}
+	fs.SetNormalizeFunc(wordSepNormalizeFunc)
+	fs.SortFlags = false
+	fs.BoolVarP(&FlagHelp, "help", "h", false,
+		"Display help message and usage information")
+	fs.BoolVarP(&FlagVerbose, "verbose", "v", false,
+		"Supply additional run messages; use --debug for more information")
+	fs.BoolVarP(&FlagDebug, "debug", "d", false,
+		"Enable additional informational and operational logging output for debug purposes")
+	fs.BoolVarP(&FlagQuiet, "quiet", "q", false,
+		"Suppress log output to stderr (the --log-file still gets it)")
+	addLogFlags(fs.FlagSet)
+	return fs
+}
+
+// parseCommandFlags parses a subcommand's arguments, and sets the log
+// up from them. On a parse error it shows the usage and returns an
+// ErrUsage; for --help it shows the usage and returns errHelp. It
+// returns the positional arguments.
+func parseCommandFlags(fs *commandFlags, args []string) (positional []string, err error) {
+	err = fs.Parse(args)
+	if nil != err {
+		commandUsage(fs)
+		return nil, usageErrorf("%s: %w", fs.name, err)
+	}
+	err = configureLog()
+	if nil != err {
+		return nil, err
+	}
+	if FlagHelp {
+		commandUsage(fs)
+		return nil, errHelp
+	}
+	return fs.Args(), nil
+}
+
+// commandUsage writes the usage line and flags of a command to stdout.
+func commandUsage(fs *commandFlags) {
+	cmd, _ := findCommand(fs.name)
+	_, _ = fmt.Fprintf(os.Stdout, "\nusage: %s %s %s\n  %s\n\n%s\n",
+		programName(), cmd.name, cmd.usage, cmd.summary, fs.FlagUsagesWrapped(75))
+}
+
+// requireArgs returns an ErrUsage, after showing the usage, unless a
+// command got between lo and hi positional arguments (hi < 0 for no
+// limit).
+func requireArgs(fs *commandFlags, args []string, lo int, hi int) error {
+	if len(args) >= lo && (hi < 0 || len(args) <= hi) {
+		return nil
+	}
+	commandUsage(fs)
+	return usageErrorf("%s takes %s, not %d", fs.name, argCount(lo, hi), len(args))
+}
+
+// argCount describes the number of arguments a command takes.
+func argCount(lo int, hi int) string {
+	switch {
+	case lo == hi:
+		return fmt.Sprintf("%d argument(s)", lo)
+	case hi < 0:
+		return fmt.Sprintf("at least %d argument(s)", lo)
+	}
+	return fmt.Sprintf("%d to %d arguments", lo, hi)
+}
+
+// commandList formats the list of commands for help output.
+func commandList() string {
+	var sb strings.Builder
+	sb.WriteString("commands:\n")
+	for _, cmd := range commands {
+		sb.WriteString(fmt.Sprintf("  %-10s %s\n", cmd.name, cmd.summary))
+	}
+	sb.WriteString(fmt.Sprintf("\nWithout a command, %s runs %s. "+
+		"Use `%s <command> --help` for a command's flags.\n",
+		programName(), defaultCommand, programName()))
+	return sb.String()
+}
+
+// runHelp implements `madcolor help [command]`.
+func runHelp(args []string) error {
+	if 0 == len(args) {
+		_, _ = fmt.Fprintf(os.Stdout, "\nusage: %s <command> [flags]\n\n%s",
+			programName(), commandList())
+		return nil
+	}
+	if _, ok := findCommand(args[0]); !ok {
+		return usageErrorf("unknown command %q", args[0])
+	}
+	return runCommand([]string{args[0], "--help"})
+}
+
+// printJSON writes v to stdout as indented JSON, for the --json output of
+// the commands.
+func printJSON(v any) error {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	err := enc.Encode(v)
+	if nil != err {
+		return fmt.Errorf("%w as JSON: %w", ErrOutput, err)
+	}
+	return nil
+}
@@ -0,0 +1,407 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/spf13/pflag"
+	htmlColor "madcolor/htmlcolor"
+)
+
+// completeCommand is the hidden command the completion scripts run to
+// get the candidates for the word being completed.
+const completeCommand = "__complete"
+
+// completeAnnotation is the pflag annotation that says how to complete
+// the value of a flag (see completeFlag).
+const completeAnnotation = "madcolor_complete"
+
+// kinds of flag values, for completeFlag
+const (
+	completeColor  = "color"
+	completeFile   = "file"
+	completePreset = "preset"
+	completeChoice = "choice"
+)
+
+// completion directives, the last line __complete writes: the shell
+// either offers the candidates above it, or completes file names.
+const (
+	directiveWords = ":words"
+	directiveFiles = ":files"
+)
+
+// completionShells are the shells `madcolor completion` writes scripts for.
+var completionShells = []string{"bash", "zsh", "fish"}
+
+// rxNotFuncName matches what a shell function name should not hold.
+var rxNotFuncName = regexp.MustCompile("[^A-Za-z0-9_]+")
+
+// completeFlag annotates a flag of fs with how to complete its value:
+// completeColor, completeFile, completePreset, or completeChoice with
+// the choices.
+func completeFlag(fs *pflag.FlagSet, name string, kind string, choices ...string) {
+	err := fs.SetAnnotation(name, completeAnnotation, append([]string{kind}, choices...))
+	if nil != err {
+		panic(fmt.Sprintf("huh? could not annotate --%s for completion: %v", name, err))
+	}
+}
+
+// newCompletionFlags returns the flag set of the completion command.
+func newCompletionFlags() *commandFlags {
+	return newCommandFlags("completion")
+}
+
+// runCompletion implements `madcolor completion bash|zsh|fish`: it
+// writes a completion script for the shell to stdout. The script asks
+// madcolor itself (see runComplete) for the candidates, so color and
+// preset names are always current.
+func runCompletion(args []string) error {
+	cFlags := newCompletionFlags()
+
+	args, err := parseCommandFlags(cFlags, args)
+	if nil == err {
+		err = requireArgs(cFlags, args, 1, 1)
+	}
+	if nil != err {
+		return err
+	}
+
+	name := programName()
+	fn := "_" + rxNotFuncName.ReplaceAllString(name, "_")
+	var script string
+	switch args[0] {
+	case "bash":
+		script = fmt.Sprintf(bashCompletion, name, fn)
+	case "zsh":
+		script = fmt.Sprintf(zshCompletion, name, fn)
+	case "fish":
+		script = fmt.Sprintf(fishCompletion, name, fn)
+	default:
+		commandUsage(cFlags)
+		return usageErrorf("completion takes one of %v, not %q", completionShells, args[0])
+	}
+	_, err = os.Stdout.WriteString(script)
+	if nil != err {
+		return fmt.Errorf("%w: %w", ErrOutput, err)
+	}
+	return nil
+}
+
+// runComplete implements the hidden `madcolor __complete <word> ...`:
+// the words are the command line after the program name, up to and
+// including the word being completed (which may be ""). It writes the
+// candidates, one per line, then a directive. Logging is discarded, as
+// the shell is in the middle of editing a line; so is an error writing
+// the candidates.
+func runComplete(args []string) error {
+	xLog = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	candidates, directive := completeWords(args)
+	var sb strings.Builder
+	for _, c := range candidates {
+		sb.WriteString(c)
+		sb.WriteByte('\n')
+	}
+	sb.WriteString(directive)
+	sb.WriteByte('\n')
+	_, _ = os.Stdout.WriteString(sb.String())
+	return nil
+}
+
+// completeWords returns the candidates for the last of words, and the
+// directive for the shell.
+func completeWords(words []string) (candidates []string, directive string) {
+	words = joinEquals(words)
+	cur := ""
+	if 0 < len(words) {
+		cur = unquoteWord(words[len(words)-1])
+		words = words[:len(words)-1]
+	}
+
+	if 0 == len(words) && !strings.HasPrefix(cur, "-") {
+		return commandNames(cur), directiveWords
+	}
+	// as in runCommand, a first word that is no command goes to colorize
+	cmd, _ := findCommand(defaultCommand)
+	if 0 < len(words) {
+		if named, ok := findCommand(words[0]); ok {
+			cmd, words = named, words[1:]
+		}
+	}
+	if nil == cmd.flags {
+		return commandNames(cur), directiveWords
+	}
+	fs := cmd.flags().FlagSet
+
+	// the value of --flag=<cur>, or of a flag given just before
+	if name, value, ok := strings.Cut(cur, "="); ok && strings.HasPrefix(name, "-") {
+		f := lookupFlag(fs, name)
+		if nil == f {
+			return nil, directiveWords
+		}
+		found, directive := completeValue(f, unquoteWord(value))
+		for ix := range found {
+			found[ix] = name + "=" + found[ix]
+		}
+		return found, directive
+	}
+	if 0 < len(words) {
+		prev := words[len(words)-1]
+		if f := lookupFlag(fs, prev); nil != f && "" == f.NoOptDefVal && !strings.Contains(prev, "=") {
+			return completeValue(f, cur)
+		}
+	}
+
+	if strings.HasPrefix(cur, "-") {
+		fs.VisitAll(func(f *pflag.Flag) {
+			if !f.Hidden && strings.HasPrefix("--"+f.Name, cur) {
+				candidates = append(candidates, "--"+f.Name)
+			}
+		})
+		return candidates, directiveWords
+	}
+	return completePositional(cmd.name, positionals(fs, words), cur)
+}
+
+// completePositional returns the candidates for a positional argument
+// of a command, given the positional arguments before it.
+func completePositional(name string, before []string, cur string) ([]string, string) {
+	switch name {
+	case "palette":
+		if 0 == len(before) {
+			return matching([]string{"list", "search", "export", "lint"}, cur), directiveWords
+		}
+	case "contrast", "convert":
+		return colorNames(cur), directiveWords
+	case "audit", "strip", "recolor":
+		return nil, directiveFiles
+	case "completion":
+		if 0 == len(before) {
+			return matching(completionShells, cur), directiveWords
+		}
+	}
+	return nil, directiveWords
+}
+
+// completeValue returns the candidates for the value of flag f, as its
+// completeAnnotation says.
+func completeValue(f *pflag.Flag, cur string) ([]string, string) {
+	how := f.Annotations[completeAnnotation]
+	if 0 == len(how) {
+		return nil, directiveWords
+	}
+	switch how[0] {
+	case completeColor:
+		return colorNames(cur), directiveWords
+	case completeFile:
+		return nil, directiveFiles
+	case completePreset:
+		return matching(presetNames(), cur), directiveWords
+	case completeChoice:
+		// slice flags take a comma separated list
+		done := ""
+		if ix := strings.LastIndex(cur, ","); 0 <= ix && "stringSlice" == f.Value.Type() {
+			done, cur = cur[:ix+1], cur[ix+1:]
+		}
+		found := matching(how[1:], cur)
+		for ix := range found {
+			found[ix] = done + found[ix]
+		}
+		return found, directiveWords
+	}
+	return nil, directiveWords
+}
+
+// joinEquals rejoins `--flag = value`, as bash splits the words at "=".
+func joinEquals(words []string) (joined []string) {
+	for ix := 0; ix < len(words); ix++ {
+		if "=" == words[ix] && 0 < len(joined) && strings.HasPrefix(joined[len(joined)-1], "-") {
+			joined[len(joined)-1] += "="
+			if ix+1 < len(words) {
+				ix++
+				joined[len(joined)-1] += words[ix]
+			}
+			continue
+		}
+		joined = append(joined, words[ix])
+	}
+	return joined
+}
+
+// unquoteWord removes the shell quoting of the word being completed: an
+// opening quote that is not closed yet, and backslash escapes.
+func unquoteWord(word string) string {
+	if strings.HasPrefix(word, "'") || strings.HasPrefix(word, "\"") {
+		return strings.TrimSuffix(word[1:], word[:1])
+	}
+	var sb strings.Builder
+	escaped := false
+	for _, r := range word {
+		if '\\' == r && !escaped {
+			escaped = true
+			continue
+		}
+		escaped = false
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
+
+// lookupFlag returns the flag of fs that word ("--name", "--name=..."
+// or "-n") names, or nil.
+func lookupFlag(fs *pflag.FlagSet, word string) *pflag.Flag {
+	word, _, _ = strings.Cut(word, "=")
+	switch {
+	case strings.HasPrefix(word, "--"):
+		return fs.Lookup(word[2:])
+	case strings.HasPrefix(word, "-") && 2 == len(word):
+		return fs.ShorthandLookup(word[1:])
+	}
+	return nil
+}
+
+// positionals returns the positional arguments among words, skipping
+// the flags and the values of flags that take one.
+func positionals(fs *pflag.FlagSet, words []string) (args []string) {
+	for ix := 0; ix < len(words); ix++ {
+		w := words[ix]
+		if !strings.HasPrefix(w, "-") || "-" == w {
+			args = append(args, w)
+			continue
+		}
+		if f := lookupFlag(fs, w); nil != f && "" == f.NoOptDefVal && !strings.Contains(w, "=") {
+			ix++ // the flag's value
+		}
+	}
+	return args
+}
+
+// commandNames returns the names of the commands that start with cur.
+func commandNames(cur string) []string {
+	names := make([]string, 0, len(commands))
+	for _, cmd := range commands {
+		names = append(names, cmd.name)
+	}
+	return matching(names, cur)
+}
+
+// colorNames returns the color names and aliases that start with cur,
+// ignoring case.
+func colorNames(cur string) (names []string) {
+	cur = strings.ToLower(cur)
+	for name := range htmlColor.ColorNames {
+		if strings.HasPrefix(name, cur) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// presetNames returns the names of the presets of the config files.
+// Files that cannot be read are skipped, as this is only completion.
+func presetNames() (names []string) {
+	for _, path := range []string{userConfigPath(), projectConfigPath()} {
+		if "" == path {
+			continue
+		}
+		cf, err := loadConfigFile(path)
+		if nil != err || nil == cf {
+			continue
+		}
+		for name := range cf.presets {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// matching returns the words that start with cur, sorted and without
+// duplicates.
+func matching(words []string, cur string) (found []string) {
+	seen := make(map[string]bool, len(words))
+	for _, w := range words {
+		if strings.HasPrefix(w, cur) && !seen[w] {
+			seen[w] = true
+			found = append(found, w)
+		}
+	}
+	sort.Strings(found)
+	return found
+}
+
+// bashCompletion is the bash completion script; %[1]s is the program
+// name and %[2]s the completion function.
+const bashCompletion = `# bash completion for %[1]s
+# load it with:  source <(%[1]s completion bash)
+%[2]s() {
+    local cur="${COMP_WORDS[COMP_CWORD]}" IFS=$'\n' c
+    [[ "$cur" == "=" ]] && cur=""
+    local out=($("%[1]s" __complete "${COMP_WORDS[@]:1:COMP_CWORD}" 2>/dev/null))
+    local directive="${out[${#out[@]}-1]}"
+    unset 'out[${#out[@]}-1]'
+    COMPREPLY=()
+    if [[ "$directive" == ":files" ]]; then
+        compopt -o filenames 2>/dev/null
+        COMPREPLY=($(compgen -f -- "$cur"))
+        return
+    fi
+    for c in "${out[@]}"; do
+        c="${c#--*=}"
+        if [[ "$cur" == [\"\']* ]]; then
+            COMPREPLY+=("$c")
+        else
+            COMPREPLY+=("$(printf '%%q' "$c")")
+        fi
+    done
+}
+complete -F %[2]s %[1]s
+`
+
+// zshCompletion is the zsh completion script; %[1]s is the program
+// name and %[2]s the completion function.
+const zshCompletion = `#compdef %[1]s
+# zsh completion for %[1]s
+# put it on your $fpath as _%[1]s, or load it with:  source <(%[1]s completion zsh)
+%[2]s() {
+    local -a out
+    out=("${(@f)$("%[1]s" __complete "${(@)words[2,CURRENT-1]}" "$PREFIX" 2>/dev/null)}")
+    local directive="${out[-1]}"
+    out=("${(@)out[1,-2]}")
+    if [[ "$directive" == ":files" ]]; then
+        _files
+        return
+    fi
+    [[ "$PREFIX" == -*=* ]] && compset -P '*='
+    compadd -- "${(@)out#--*=}"
+}
+if [[ "$funcstack[1]" == "%[2]s" ]]; then
+    %[2]s "$@"
+else
+    compdef %[2]s %[1]s
+fi
+`
+
+// fishCompletion is the fish completion script; %[1]s is the program
+// name and %[2]s the completion function.
+const fishCompletion = `# fish completion for %[1]s
+# load it with:  %[1]s completion fish | source
+function %[2]s
+    set -l out ("%[1]s" __complete (commandline -opc)[2..-1] (commandline -ct) 2>/dev/null)
+    test (count $out) -gt 0; or return
+    set -l directive $out[-1]
+    set -e out[-1]
+    if test "$directive" = ":files"
+        __fish_complete_path (commandline -ct)
+        return
+    end
+    printf '%%s\n' $out
+end
+complete -c %[1]s -f -a '(%[2]s)'
+`
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// complete runs `madcolor __complete words...` and returns the
+// candidates and the directive it writes.
+func complete(t *testing.T, words ...string) (candidates []string, directive string) {
+	t.Helper()
+	defer func(l *slog.Logger) { xLog = l }(xLog)
+
+	r, w, err := os.Pipe()
+	if nil != err {
+		t.Fatal(err)
+	}
+	stdout := os.Stdout
+	os.Stdout = w
+	err = runCommand(append([]string{completeCommand}, words...))
+	os.Stdout = stdout
+	_ = w.Close()
+	out, readErr := io.ReadAll(r)
+	_ = r.Close()
+	if nil != err || nil != readErr {
+		t.Fatalf("__complete %q: %v %v", words, err, readErr)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(string(out), "\n"), "\n")
+	return lines[:len(lines)-1], lines[len(lines)-1]
+}
+
+// TestComplete checks the candidates __complete offers for command
+// names, flag names, flag values (including --flag=value and the words
+// bash splits at "="), color names and presets, and that shell quoting
+// of the word being completed is undone.
+func TestComplete(t *testing.T) {
+	dir := isolate(t)
+	configDirs(t, dir, "[preset.slack-dark]\n[preset.solar]\n", "[preset.slides]\n")
+
+	cases := []struct {
+		name      string
+		words     []string
+		want      []string // all among the candidates
+		exact     bool     // and no others
+		directive string
+	}{
+		{"commands", []string{"co"}, []string{"colorize", "completion", "contrast", "convert"}, true, directiveWords},
+		{"flag names", []string{"colorize", "--back"}, []string{"--background-color"}, true, directiveWords},
+		{"default command flags", []string{"--inv"}, []string{"--invent"}, true, directiveWords},
+		{"contrast levels", []string{"colorize", "--contrast", ""}, wcagLevelNames(), true, directiveWords},
+		{"audit contrast levels", []string{"audit", "-c", "AA"}, []string{"AA", "AA-large"}, false, directiveWords},
+		{"choice", []string{"colorize", "--mode=h"}, []string{"--mode=hash"}, true, directiveWords},
+		{"bash split at =", []string{"colorize", "--mode", "=", "h"}, []string{"--mode=hash"}, true, directiveWords},
+		{"slice choice", []string{"palette", "list", "--palette-source", "css,cra"}, []string{"css,crayola"}, true, directiveWords},
+		{"color names", []string{"convert", "alice"}, []string{"alice blue", "aliceblue"}, false, directiveWords},
+		{"color flag", []string{"colorize", "-b", "nav"}, []string{"navy"}, false, directiveWords},
+		{"quoted", []string{"convert", "'alice b"}, []string{"alice blue"}, true, directiveWords},
+		{"escaped", []string{"convert", `alice\ b`}, []string{"alice blue"}, true, directiveWords},
+		{"presets", []string{"colorize", "--preset", "sl"}, []string{"slack-dark", "slides"}, true, directiveWords},
+		{"subcommands", []string{"palette", ""}, []string{"export", "lint", "list", "search"}, true, directiveWords},
+		{"files", []string{"audit", ""}, nil, true, directiveFiles},
+		{"file flag", []string{"colorize", "--output", "x"}, nil, true, directiveFiles},
+		{"no command", []string{"somefile", "--inv"}, []string{"--invent"}, true, directiveWords},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, directive := complete(t, c.words...)
+			if c.directive != directive {
+				t.Errorf("directive %q, want %q", directive, c.directive)
+			}
+			for _, w := range c.want {
+				if !slices.Contains(got, w) {
+					t.Errorf("no %q among %q", w, got)
+				}
+			}
+			if c.exact && len(c.want) != len(got) {
+				t.Errorf("got %q, want %q", got, c.want)
+			}
+		})
+	}
+}
+
+// TestBashCompletion sources the bash script against a stand-in for
+// madcolor, and checks how the candidates are quoted for the word
+// being completed.
+func TestBashCompletion(t *testing.T) {
+	bash, err := exec.LookPath("bash")
+	if nil != err {
+		t.Skip("no bash")
+	}
+	dir := t.TempDir()
+	program := filepath.Join(dir, "madcolor")
+	err = os.WriteFile(program, []byte("#!/bin/sh\nprintf 'alice blue\\n--background-color=navy blue\\n:words\\n'\n"), 0777)
+	if nil != err {
+		t.Fatal(err)
+	}
+	script := fmt.Sprintf(bashCompletion, program, "_madcolor")
+
+	cases := []struct {
+		cur  string
+		want string
+	}{
+		{"ali", `alice\ blue|navy\ blue`},
+		{"'ali", "alice blue|navy blue"},
+		{`"ali`, "alice blue|navy blue"},
+	}
+	for _, c := range cases {
+		out, err := exec.Command(bash, "-c", script+`
+COMP_WORDS=(madcolor convert "$1"); COMP_CWORD=2
+_madcolor
+IFS='|'; printf '%s' "${COMPREPLY[*]}"`, "bash", c.cur).CombinedOutput()
+		if nil != err || c.want != string(out) {
+			t.Errorf("completing %s gave %q, %v, want %q", c.cur, out, err, c.want)
+		}
+	}
+}
+
+// TestCompletionScripts checks that a script is written for each shell,
+// and that the bash one parses.
+func TestCompletionScripts(t *testing.T) {
+	isolate(t)
+	for _, shell := range completionShells {
+		script := fmt.Sprintf(map[string]string{"bash": bashCompletion, "zsh": zshCompletion,
+			"fish": fishCompletion}[shell], "madcolor", "_madcolor")
+		if !strings.Contains(script, "madcolor __complete") && !strings.Contains(script, `"madcolor" __complete`) {
+			t.Errorf("the %s script does not call __complete:\n%s", shell, script)
+		}
+		if strings.Contains(script, "%!") {
+			t.Errorf("the %s script has a formatting error:\n%s", shell, script)
+		}
+		if path, err := exec.LookPath(shell); nil == err && "fish" != shell {
+			out, err := exec.Command(path, "-n", "-c", script).CombinedOutput()
+			if nil != err {
+				t.Errorf("the %s script does not parse: %v\n%s", shell, err, out)
+			}
+		}
+	}
+	if err := run(t, "completion", "tcsh"); nil == err {
+		t.Errorf("completion tcsh was accepted")
+	}
+}
@@ -1,11 +1,17 @@
 package main
 
 import (
+	"context"
 	"fmt"
+	"log/slog"
+	"math"
 	"os"
 	"path/filepath"
 	"runtime/debug"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/pflag"
 	"madcolor/misc"
@@ -21,6 +27,10 @@ func wordSepNormalizeFunc(_ *pflag.FlagSet, name string) pflag.NormalizedName {
 
 var nFlags *pflag.FlagSet
 
+// nSources records where each flag of nFlags that is not at its default
+// got its value (see applyConfig and flagSet).
+var nSources map[string]string
+
 /* secret flags */
 var FlagSlow bool
 
@@ -38,117 +48,110 @@ var FlagText string
 var FlagInventColor bool
 var FlagAntiColor bool
 var FlagOutput string
+var FlagAnnotate bool
 var FlagOutputDir string
 var FlagInput string
 var FlagClip bool
 var FlagStdout bool
 var FlagPipe bool
 var FlagDistance int8 = 20
+var FlagOnUnsatisfiable string
 var FlagClipboardBuffer bool
 var FlagImport = ""
+var FlagMode string
+var FlagUnit string
+var FlagSalt string
+var FlagCSS string
+var FlagCSSFile string
+var FlagClassPrefix string
+var FlagOptimize bool
+var FlagFormat string
+var FlagPasteHTML bool
+var FlagBuffFormat string
+var FlagClipboardBackend string
+var FlagClipboardFile string
+var FlagWatchClipboard bool
+var FlagWatchTrigger string
+var FlagWatchInterval time.Duration
+var FlagPreset string
+
+// color selection modes (see --mode)
+const (
+	modeRandom = "random"
+	modeHash   = "hash"
+)
 
-// initFlags initializes the command line flags for the program.
-// It sets up the flag set, defines the flags, and parses the command line arguments.
-func initFlags() {
-	var err error
-
-	// [output-name][opt-name]
-	hideFlags := make(map[string]string, 8)
-
-	nFlags = pflag.NewFlagSet("default", pflag.ContinueOnError)
-	nFlags.SetNormalizeFunc(wordSepNormalizeFunc)
-
-	// secret flags
-	nFlags.StringVarP(&FlagOutputDir, "output-dir", "", "",
-		"output directory for output file")
-	// hideFlags["FlagOutputDir"] = "output-dir"
-
-	// standard flags
-
-	nFlags.BoolVarP(&FlagSlow, "slow", "", false,
-		"Add some time between http calls (do not hammer server)")
-
-	nFlags.BoolVarP(&FlagDebug, "debug", "d",
-		false, "Enable additional informational and operational logging output for debug purposes")
-
-	nFlags.BoolVarP(&FlagVerbose, "verbose", "v",
-		false, "Supply additional run messages; use --debug for more information")
-
-	nFlags.BoolVarP(&FlagHelp, "help", "h",
-		false, "Display help message and usage information")
-
-	nFlags.BoolVarP(&FlagQuiet, "quiet", "q",
-		true, "Suppress log output to stdout and stderr (output still goes to logfile)")
-
-	// program flags
-
-	nFlags.StringVarP(&FlagImport, "import", "", "",
-		"Import a file of colors as <<colorname=#FD01AB>> (not yet implemented)")
-
-	nFlags.Int8VarP(&FlagContrast, "contrast", "c", int8(minContrast),
-		"minimum relative contrast between foreground and background")
-
-	nFlags.Int8VarP(&FlagDistance, "distance", "D", int8(minColorDistance),
-		"minimum relative contrast between foreground and background")
+// colorizing units (see --unit)
+const (
+	unitGlyph = "glyph"
+	unitWord  = "word"
+)
 
-	nFlags.StringVarP(&FlagBackgroundColor, "background-color", "b", "white",
-		"Background color. Ignored for --anti.")
+// initFlags initializes the command line flags for the colorize command.
+// It sets up the flag set, defines the flags, and parses the arguments.
+// Bad flags, config files and option values are an ErrUsage; after
+// --help it returns errHelp.
+func initFlags(args []string) error {
+	var err error
 
-	nFlags.BoolVarP(&FlagClipboardBuffer, "buff", "", true,
-		"buffer mode -- convert text in the clipboard buffer")
+	nFlags = newColorizeFlags()
+	nSources = make(map[string]string)
 
-	nFlags.BoolVarP(&FlagPipe, "pipe", "p", false,
-		"Pipe mode; read from STDIN, write to STDOUT, all other io disabled.")
+	// Fetch and load the program flags
+	err = nFlags.Parse(args)
+	if nil != err {
+		_, _ = fmt.Fprintf(os.Stderr, "\n%s\n", nFlags.FlagUsagesWrapped(60))
+		return usageErrorf("%w (a common issue is 2 hyphens for long-form "+
+			"arguments and 1 hyphen for short-form ones)", err)
+	}
 
-	// this USUALLY defaults to TRUE, but is set to FALSE if the user does not
-	// explicitly specify it when using --output.
-	nFlags.BoolVarP(&FlagStdout, "stdout", "", true,
-		"Write to STDOUT as well as the output file")
+	nSources, err = applyConfig(nFlags)
+	if nil != err {
+		return usageErrorf("could not apply the config: %w", err)
+	}
 
-	nFlags.BoolVarP(&FlagClip, "nopaste", "", true,
-		"Suppress paste of buffer to clipboard (if clipboard is available)")
+	err = configureLog()
+	if nil != err {
+		return err
+	}
 
-	nFlags.BoolVarP(&FlagAntiColor, "anti", "a", false,
-		"Set the colorspace background to the foreground complement "+
-			"or something random with minimum contrast (see --contrast)")
+	if 0 < nFlags.NArg() {
+		xLog.Warn("ignoring arguments that are not flags; "+
+			"run `"+programName()+" help` for the list of commands", "args", nFlags.Args())
+	}
 
-	nFlags.BoolVarP(&FlagInventColor, "invent", "I", false,
-		"randomly generate colors (rather than randomly select known/named colors)")
+	err = optionsFromFlags().validate()
+	if nil != err {
+		return usageErrorf("%w", err)
+	}
 
-	nFlags.StringVarP(&FlagText, "text", "t",
-		DEFAULTCOLORTEXT, "Text to colorize")
+	err = setPaletteSources()
+	if nil != err {
+		return err
+	}
 
-	nFlags.StringVarP(&FlagInput, "input", "i",
-		"", "Input file to colorize, defaults to stdin")
+	if FlagBuffFormat != clipFormatText && FlagBuffFormat != clipFormatHTML {
+		return usageErrorf("--buff-format must be %s or %s, not %q", clipFormatText, clipFormatHTML, FlagBuffFormat)
+	}
 
-	nFlags.StringVarP(&FlagOutput, "output", "o",
-		"", "Write colorized text to file instead of STDOUT. Use --stdout if output should go both to file and STDOUT.")
+	if !slices.Contains(clipBackendNames, FlagClipboardBackend) {
+		return usageErrorf("--clipboard-backend must be one of %s, not %q",
+			strings.Join(clipBackendNames, ", "), FlagClipboardBackend)
+	}
 
-	for flagName, optName := range hideFlags {
-		err = nFlags.MarkHidden(optName)
-		if nil != err {
-			xLog.Printf("could not mark option %s as %s hidden because %s\n",
-				optName, flagName, err.Error())
-			myFatal()
-		}
+	if FlagWatchClipboard && FlagPipe {
+		return usageErrorf("--watch-clipboard and --pipe are incompatible options")
 	}
 
-	// Fetch and load the program flags
-	err = nFlags.Parse(os.Args[1:])
-	if nil != err {
-		_, _ = fmt.Fprintf(os.Stderr, "\n%s\n", nFlags.FlagUsagesWrapped(60))
-		xLog.Printf("\nerror parsing flags because: %s\n%s %s\n%s\n\t%v\n",
-			err.Error(),
-			"  common issue: 2 hyphens for long-form arguments,",
-			"  1 hyphen for short-form argument",
-			"  Program arguments are: ",
-			os.Args)
-		myFatal(-2)
+	// --buff is on by default, so an explicit --pipe or --input turns it
+	// off; only an explicit --buff conflicts with them.
+	if FlagClipboardBuffer && !nFlags.Changed("buff") &&
+		(FlagPipe || misc.IsStringSet(&FlagInput)) {
+		flagSet("buff", "false")
 	}
 
 	if FlagClipboardBuffer && FlagPipe {
-		xLog.Println("--buff and --pipe are incompatible options.")
-		myFatal(-2)
+		return usageErrorf("--buff and --pipe are incompatible options")
 	}
 
 	if FlagClipboardBuffer {
@@ -161,28 +164,17 @@ func initFlags() {
 		flagSet("stdout", "true")
 		flagSet("input", "")
 		flagSet("output", "")
-		flagSet("quiet", "true")
 	}
 
-	// do quietness
-	// only write to logfile not stderr
-	// for debug and verbose messages
-	if FlagQuiet {
-		xLog.SetOutput(xLogBuffer)
-		// messages only to logfile, not stderr
-	}
+	initializeClipboard()
 
 	if FlagClip && !modeClipboardAvailable {
-		if FlagVerbose {
-			xLog.Printf("This system does not offer a clipboard to paste to! --nopaste enabled!")
-		}
+		xLog.Info("This system does not offer a clipboard to paste to; --nopaste enabled")
 		flagSet("nopaste", "false")
 	}
 
-	if FlagDebug && FlagVerbose {
-		xLog.Println("\t\t/*** start program flags ***/\n")
-		nFlags.VisitAll(logFlag)
-		xLog.Println("\t\t/***   end program flags ***/")
+	if xLog.Enabled(context.Background(), slog.LevelDebug) {
+		nFlags.VisitAll(func(flag *pflag.Flag) { logFlag(nSources, flag) })
 	}
 
 	if FlagHelp {
@@ -191,34 +183,32 @@ func initFlags() {
 		_, err1 = fmt.Fprint(os.Stdout, "\n", "usage for ", thisCmd, ":\n")
 		_, err2 = fmt.Fprintf(os.Stdout, "%s\n", nFlags.FlagUsagesWrapped(75))
 		if nil != err1 || nil != err2 {
-			xLog.Printf("huh? can't write to os.stdout because\n%s",
-				misc.ConcatenateErrors(err1, err2).Error())
+			xLog.Error("Could not write the usage", "err", misc.ConcatenateErrors(err1, err2))
 		}
+		_, _ = fmt.Fprintf(os.Stdout, "\n%s", commandList())
 		UsageMessage()
 		_, _ = fmt.Fprintf(os.Stdout, "\t please see USAGE.MD for details")
-		myFatal(0)
+		return errHelp
 	}
 
-	if FlagVerbose {
-		errMsg := ""
+	if xLog.Enabled(context.Background(), slog.LevelInfo) {
 		user, host, err := misc.UserHostInfo()
 		if nil != err {
-			errMsg = " (encountered error " + err.Error() + ")"
+			xLog.Info("starting", "user", user, "host", host, "err", err)
+		} else {
+			xLog.Info("starting", "user", user, "host", host)
 		}
-		xLog.Printf("Verbose mode active (all debug and informative messages) for %s@%s%s",
-			user, host, errMsg)
 	}
 
-	if FlagDebug && FlagVerbose {
+	if xLog.Enabled(context.Background(), slog.LevelDebug) {
 		_, exeName := filepath.Split(os.Args[0])
 		exeName = strings.TrimSuffix(exeName, filepath.Ext(exeName))
 		bi, ok := debug.ReadBuildInfo()
 		if !ok {
-			xLog.Printf("huh? Could not read build information for %s "+
-				"-- perhaps compiled without module support?", exeName)
+			xLog.Debug("Could not read build information "+
+				"-- perhaps compiled without module support?", "program", exeName)
 		} else {
-			xLog.Printf("\n***** %s BuildInfo: *****\n%s\n%s\n",
-				exeName, bi.String(), strings.Repeat("*", 22+len(exeName)))
+			xLog.Debug("build information", "program", exeName, "build", bi.String())
 		}
 	}
 
@@ -232,28 +222,179 @@ func initFlags() {
 			flagSet("stdout", "true")
 		}
 	}
+	return nil
+}
+
+// newColorizeFlags returns the flag set of the colorize command, with
+// every flag defined.
+func newColorizeFlags() *pflag.FlagSet {
+	// [output-name][opt-name]
+	hideFlags := make(map[string]string, 8)
+
+	fs := pflag.NewFlagSet("colorize", pflag.ContinueOnError)
+	fs.SetNormalizeFunc(wordSepNormalizeFunc)
+
+	// secret flags
+	fs.StringVarP(&FlagOutputDir, "output-dir", "", "",
+		"output directory for output file")
+	// hideFlags["FlagOutputDir"] = "output-dir"
+
+	// standard flags
+
+	fs.BoolVarP(&FlagSlow, "slow", "", false,
+		"Add some time between http calls (do not hammer server)")
+
+	fs.BoolVarP(&FlagDebug, "debug", "d",
+		false, "Enable additional informational and operational logging output for debug purposes")
+
+	fs.BoolVarP(&FlagVerbose, "verbose", "v",
+		false, "Supply additional run messages; use --debug for more information")
+
+	fs.BoolVarP(&FlagHelp, "help", "h",
+		false, "Display help message and usage information")
+
+	fs.BoolVarP(&FlagQuiet, "quiet", "q",
+		false, "Suppress log output to stderr (the --log-file still gets it)")
+
+	addLogFlags(fs)
+
+	// program flags
+
+	fs.StringVarP(&FlagImport, "import", "", "",
+		"Import a file of colors as <<colorname=#FD01AB>> (not yet implemented)")
+
+	fs.VarP(newContrastValue(&FlagContrast, int8(minContrast)), "contrast", "c",
+		"minimum relative contrast between foreground and background, as a percentage "+
+			"or a WCAG level (AA, AA-large, AAA, AAA-large) or ratio (4.5:1)")
+
+	fs.Int8VarP(&FlagDistance, "distance", "D", int8(minColorDistance),
+		"minimum RGB distance between foreground and background, as a percentage of black to white")
+
+	fs.StringVarP(&FlagOnUnsatisfiable, "on-unsatisfiable", "", unsatBestEffort,
+		"When no color meets --contrast and --distance: fail, relax them, or best-effort (black or white)")
+
+	fs.StringVarP(&FlagBackgroundColor, "background-color", "b", "white",
+		"Background color. Ignored for --anti.")
+
+	fs.BoolVarP(&FlagClipboardBuffer, "buff", "", true,
+		"buffer mode -- convert text in the clipboard buffer")
+
+	fs.StringVarP(&FlagBuffFormat, "buff-format", "", clipFormatText,
+		"Clipboard flavor read by --buff: text, or html (colorize the text of the text/html flavor)")
+
+	fs.BoolVarP(&FlagPasteHTML, "paste-html", "", true,
+		"Paste HTML output to the clipboard as text/html too, so it pastes as colored text (with the x11 and file clipboards)")
+
+	fs.StringVarP(&FlagClipboardBackend, "clipboard-backend", "", clipBackendAuto,
+		"Clipboard to use: "+strings.Join(clipBackendNames, ", "))
+
+	fs.StringVarP(&FlagClipboardFile, "clipboard-file", "", defaultClipboardFile(),
+		"File that holds the clipboard for --clipboard-backend file (HTML goes to <file>.html)")
+
+	fs.BoolVarP(&FlagWatchClipboard, "watch-clipboard", "", false,
+		"Keep running and colorize each new text on the clipboard in place (interrupt to stop)")
+
+	fs.StringVarP(&FlagWatchTrigger, "watch-trigger", "", "",
+		"With --watch-clipboard, only colorize text that starts with this prefix (e.g. \"!mc \")")
+
+	fs.DurationVarP(&FlagWatchInterval, "watch-interval", "", 500*time.Millisecond,
+		"With --watch-clipboard, how often to check clipboards that cannot report changes")
+
+	fs.BoolVarP(&FlagPipe, "pipe", "p", false,
+		"Pipe mode; read from STDIN, write to STDOUT, all other io disabled.")
+
+	// this USUALLY defaults to TRUE, but is set to FALSE if the user does not
+	// explicitly specify it when using --output.
+	fs.BoolVarP(&FlagStdout, "stdout", "", true,
+		"Write to STDOUT as well as the output file")
+
+	fs.BoolVarP(&FlagClip, "nopaste", "", true,
+		"Suppress paste of buffer to clipboard (if clipboard is available)")
+
+	fs.BoolVarP(&FlagAntiColor, "anti", "a", false,
+		"Set the colorspace background to the foreground complement "+
+			"or something random with minimum contrast (see --contrast)")
+
+	fs.BoolVarP(&FlagInventColor, "invent", "I", false,
+		"randomly generate colors (rather than randomly select known/named colors)")
+
+	fs.StringVarP(&FlagMode, "mode", "m", modeRandom,
+		"Color selection mode: random, or hash (the same unit always gets the same color)")
+
+	fs.StringVarP(&FlagUnit, "unit", "u", unitGlyph,
+		"Unit that receives a single color: glyph or word")
+
+	fs.StringVarP(&FlagSalt, "salt", "", "",
+		"Salt mixed into the hash for --mode hash (different salt, different colors)")
+
+	fs.StringVarP(&FlagFormat, "format", "f", formatHTML,
+		"Output format: html, or ansi (24-bit color escape sequences for the terminal)")
+
+	fs.StringVarP(&FlagCSS, "css", "", cssInline,
+		"Output styling: inline (style attribute on every span) or classes (class per span plus one stylesheet)")
+
+	fs.StringVarP(&FlagCSSFile, "css-file", "", "",
+		"With --css classes, write the stylesheet to this file instead of a <style> block")
+
+	fs.StringVarP(&FlagClassPrefix, "class-prefix", "", "mc-",
+		"Prefix for the class names generated by --css classes")
+
+	fs.BoolVarP(&FlagOptimize, "optimize", "", false,
+		"Merge adjacent spans with identical colors, drop invisible whitespace styling and minify the markup")
+
+	fs.BoolVarP(&FlagAnnotate, "annotate", "", false,
+		"Add a title to each span naming its color (or, for invented colors, the nearest named color)")
+
+	addPaletteSourceFlag(fs)
+
+	fs.StringVarP(&FlagPreset, "preset", "", "",
+		"Use the settings of this preset from the config file (see [preset.<name>])")
+
+	fs.StringVarP(&FlagText, "text", "t",
+		DEFAULTCOLORTEXT, "Text to colorize")
+
+	fs.StringVarP(&FlagInput, "input", "i",
+		"", "Input file to colorize, defaults to stdin")
+
+	fs.StringVarP(&FlagOutput, "output", "o",
+		"", "Write colorized text to file instead of STDOUT. Use --stdout if output should go both to file and STDOUT.")
+
+	completeFlag(fs, "background-color", completeColor)
+	completeFlag(fs, "preset", completePreset)
+	for _, name := range []string{"import", "input", "output", "css-file", "clipboard-file", "output-dir"} {
+		completeFlag(fs, name, completeFile)
+	}
+	completeFlag(fs, "mode", completeChoice, modeRandom, modeHash)
+	completeFlag(fs, "unit", completeChoice, unitGlyph, unitWord)
+	completeFlag(fs, "format", completeChoice, formatHTML, formatANSI)
+	completeFlag(fs, "css", completeChoice, cssInline, cssClasses)
+	completeFlag(fs, "buff-format", completeChoice, clipFormatText, clipFormatHTML)
+	completeFlag(fs, "clipboard-backend", completeChoice, clipBackendNames...)
+	completeFlag(fs, "contrast", completeChoice, wcagLevelNames()...)
+	completeFlag(fs, "on-unsatisfiable", completeChoice, unsatPolicies...)
 
+	for flagName, optName := range hideFlags {
+		err := fs.MarkHidden(optName)
+		if nil != err {
+			panic(fmt.Sprintf("huh? could not hide --%s (%s): %v", optName, flagName, err))
+		}
+	}
+	return fs
 }
 
-// logFlag -- This writes out to the logger the value of a
-// particular flag. Called indirectly. `Write()` is called
-// directly to prevent escape interactions with backslash in
-// filenames. **** you, Bill, for having to be 'different'.
-func logFlag(flag *pflag.Flag) {
-	var sb strings.Builder
-	sb.WriteString(" flag ")
-	sb.WriteString(flag.Name)
-	sb.WriteString(" has value [")
-	sb.Write([]byte(flag.Value.String()))
-	sb.WriteString("] with default [")
-	sb.Write([]byte(flag.DefValue))
-	sb.WriteString("]\n")
-	_, err := xLog.Writer().Write([]byte(sb.String()))
-	if nil != err {
-		xLog.Printf("Huh? Could not log flag %s because %s",
-			flag.Name, err.Error())
-		myFatal()
+// logFlag -- This writes out to the logger, at the debug level, the
+// value of a particular flag, and where the value came from (as
+// recorded in sources: the command line, a config file and line, a
+// preset, or the default). Called indirectly. The values are fields
+// rather than part of the message, so backslashes in filenames survive
+// both the text and the JSON format.
+func logFlag(sources map[string]string, flag *pflag.Flag) {
+	source, ok := sources[flag.Name]
+	if !ok {
+		source = sourceDefault
 	}
+	xLog.Debug("flag", "name", flag.Name, "value", flag.Value.String(),
+		"default", flag.DefValue, "source", source)
 }
 
 // UsageMessage prints useful information to the log
@@ -261,17 +402,54 @@ func logFlag(flag *pflag.Flag) {
 //
 //	UsageMessage()
 func UsageMessage() {
-	xLog.Printf("Documentation in .MD file")
+	xLog.Info("Documentation in .MD file")
 }
 
 // flagSet sets the value of a flag in nFlags set.
-// If an error occurs while setting the flag value, it logs an error message
-// using the xLog package and calls the myFatal function.
+// The flags and values are the program's own, so an error setting one
+// is a bug, and panics.
 func flagSet(flag string, val string) {
 	err := nFlags.Set(flag, val)
 	if nil != err {
-		xLog.Printf("huh? Could not set Flag [%s] to [%s] because %s",
-			flag, val, err.Error())
-		myFatal()
+		panic(fmt.Sprintf("huh? could not set --%s to %q: %v", flag, val, err))
+	}
+	nSources[flag] = sourceImplied
+}
+
+// contrastValue is the value of --contrast: a percentage of relative
+// contrast (see htmlColor.ColorDistance), or a WCAG level or ratio,
+// which is taken as the smallest percentage that meets it.
+type contrastValue struct {
+	p *int8
+}
+
+// newContrastValue returns the value for a --contrast flag that sets
+// p, which starts at val.
+func newContrastValue(p *int8, val int8) *contrastValue {
+	*p = val
+	return &contrastValue{p: p}
+}
+
+func (c *contrastValue) String() string {
+	return strconv.Itoa(int(*c.p))
+}
+
+func (c *contrastValue) Type() string {
+	return "contrast"
+}
+
+func (c *contrastValue) Set(s string) error {
+	pct, err := strconv.ParseInt(strings.TrimSpace(s), 10, 8)
+	if nil == err {
+		*c.p = int8(pct)
+		return nil
+	}
+	_, ratio, err := parseContrastLevel(s)
+	if nil != err {
+		return fmt.Errorf("contrast must be a percentage, a WCAG level (AA, AA-large, AAA, AAA-large) "+
+			"or a ratio from 1 to 21, not %q", s)
 	}
+	// relative contrast is 1 - 1/ratio
+	*c.p = int8(math.Ceil(100 * (1 - 1/ratio)))
+	return nil
 }
@@ -0,0 +1,484 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/spf13/pflag"
+	"madcolor/misc"
+)
+
+// projectConfigName is the project config file, looked for in the
+// current directory and each directory above it.
+const projectConfigName = ".madcolor.toml"
+
+// presetTable is the table that holds the presets: [preset.<name>].
+const presetTable = "preset"
+
+// configValue is one `key = value` of a config file, with its value as
+// the text a flag accepts (an array becomes a comma separated list).
+type configValue struct {
+	key   string
+	value string
+	line  int
+}
+
+// configFile is a parsed config file: the flag defaults at its top
+// level, and its presets by name.
+type configFile struct {
+	path    string
+	values  []configValue
+	presets map[string][]configValue
+}
+
+// userConfigPath returns the user config file,
+// $XDG_CONFIG_HOME/madcolor/config.toml (where XDG_CONFIG_HOME defaults
+// to ~/.config), or "" if there is no home directory to find it in.
+func userConfigPath() string {
+	dir := os.Getenv("XDG_CONFIG_HOME")
+	if "" == dir {
+		home, err := os.UserHomeDir()
+		if nil != err {
+			return ""
+		}
+		dir = filepath.Join(home, ".config")
+	}
+	return filepath.Join(dir, "madcolor", "config.toml")
+}
+
+// projectConfigPath returns the nearest .madcolor.toml in the current
+// directory or above it, or "" if there is none.
+func projectConfigPath() string {
+	dir, err := os.Getwd()
+	if nil != err {
+		return ""
+	}
+	for {
+		path := filepath.Join(dir, projectConfigName)
+		if info, err := os.Stat(path); nil == err && !info.IsDir() {
+			return path
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
+
+// loadConfigFile reads and parses the config file at path. A file that
+// does not exist is not an error: it returns nil.
+func loadConfigFile(path string) (*configFile, error) {
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if nil != err {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+
+	cf := &configFile{path: path, presets: make(map[string][]configValue)}
+	table := "" // the preset the values go to, "" at the top level
+	seen := make(map[string]bool)
+
+	scanner := bufio.NewScanner(f)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if isComment(line) {
+			continue
+		}
+		fail := func(format string, a ...any) error {
+			return fmt.Errorf("%s:%d: %s", path, n, fmt.Sprintf(format, a...))
+		}
+
+		if strings.HasPrefix(line, "[") {
+			header, rest, ok := strings.Cut(line[1:], "]")
+			if !ok || !isComment(rest) {
+				return nil, fail("a table header must be [name], not %s", line)
+			}
+			keys, err := splitKey(header)
+			if nil != err {
+				return nil, fail("%s", err.Error())
+			}
+			if 2 != len(keys) || presetTable != keys[0] {
+				return nil, fail("the only tables are [%s.<name>], not [%s]", presetTable, header)
+			}
+			table = keys[1]
+			if _, dup := cf.presets[table]; dup {
+				return nil, fail("preset %s is defined twice", table)
+			}
+			cf.presets[table] = []configValue{}
+			continue
+		}
+
+		rawKey, rawValue, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fail("expected key = value, not %s", line)
+		}
+		keys, err := splitKey(rawKey)
+		if nil != err {
+			return nil, fail("%s", err.Error())
+		}
+		if 1 != len(keys) {
+			return nil, fail("dotted keys are not supported: %s", strings.TrimSpace(rawKey))
+		}
+		value, err := parseConfigValue(strings.TrimSpace(rawValue))
+		if nil != err {
+			return nil, fail("%s: %s", keys[0], err.Error())
+		}
+		if seen[table+"."+keys[0]] {
+			return nil, fail("%s is set twice", keys[0])
+		}
+		seen[table+"."+keys[0]] = true
+
+		cv := configValue{key: keys[0], value: value, line: n}
+		if "" == table {
+			cf.values = append(cf.values, cv)
+		} else {
+			cf.presets[table] = append(cf.presets[table], cv)
+		}
+	}
+	if err := scanner.Err(); nil != err {
+		return nil, err
+	}
+	return cf, nil
+}
+
+// isComment reports whether the rest of a line is blank or a comment.
+func isComment(rest string) bool {
+	rest = strings.TrimSpace(rest)
+	return "" == rest || strings.HasPrefix(rest, "#")
+}
+
+// splitKey splits a key or table name at its dots into bare keys
+// (letters, digits, "-" and "_") and quoted keys.
+func splitKey(s string) (keys []string, err error) {
+	s = strings.TrimSpace(s)
+	for {
+		var key string
+		if strings.HasPrefix(s, "\"") || strings.HasPrefix(s, "'") {
+			key, s, err = parseString(s)
+			if nil != err {
+				return nil, err
+			}
+		} else {
+			end := strings.IndexFunc(s, func(r rune) bool { return !isBareKeyRune(r) })
+			if end < 0 {
+				end = len(s)
+			}
+			key, s = s[:end], s[end:]
+			if "" == key {
+				return nil, fmt.Errorf("a key must not be empty")
+			}
+		}
+		keys = append(keys, key)
+		s = strings.TrimSpace(s)
+		if "" == s {
+			return keys, nil
+		}
+		if !strings.HasPrefix(s, ".") {
+			return nil, fmt.Errorf("unexpected %q in a key", s)
+		}
+		s = strings.TrimSpace(s[1:])
+	}
+}
+
+// isBareKeyRune reports whether r may appear in a bare key.
+func isBareKeyRune(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') ||
+		'-' == r || '_' == r
+}
+
+// parseConfigValue parses the value of a `key = value`: a string, an
+// integer, a float, a boolean or a one-line array of them, followed by
+// nothing but a comment. It returns the value as a flag would take it.
+func parseConfigValue(s string) (string, error) {
+	value, rest, err := parseScalar(s)
+	if nil != err {
+		return "", err
+	}
+	if !isComment(rest) {
+		return "", fmt.Errorf("unexpected %q after the value", rest)
+	}
+	return value, nil
+}
+
+// parseScalar parses the value at the start of s and returns it with
+// the rest of s. Arrays are parsed here too, as they nest no further.
+func parseScalar(s string) (value string, rest string, err error) {
+	switch {
+	case "" == s:
+		return "", "", fmt.Errorf("missing value")
+	case strings.HasPrefix(s, "\"") || strings.HasPrefix(s, "'"):
+		return parseString(s)
+	case strings.HasPrefix(s, "["):
+		return parseArray(s)
+	}
+
+	end := strings.IndexAny(s, " \t,]#")
+	if end < 0 {
+		end = len(s)
+	}
+	word, rest := s[:end], s[end:]
+	switch {
+	case "true" == word || "false" == word:
+		return word, rest, nil
+	case isNumber(word):
+		return strings.ReplaceAll(word, "_", ""), rest, nil
+	}
+	return "", "", fmt.Errorf("%s is not a string, number, boolean or array (strings need quotes)", word)
+}
+
+// isNumber reports whether word is a TOML integer or float.
+func isNumber(word string) bool {
+	plain := strings.ReplaceAll(word, "_", "")
+	if _, err := strconv.ParseInt(plain, 10, 64); nil == err {
+		return true
+	}
+	_, err := strconv.ParseFloat(plain, 64)
+	return nil == err
+}
+
+// parseArray parses a one-line array such as ["css", "crayola"] into a
+// comma separated list, as the slice flags take them.
+func parseArray(s string) (value string, rest string, err error) {
+	var items []string
+	s = strings.TrimSpace(s[1:])
+	for !strings.HasPrefix(s, "]") {
+		var item string
+		item, s, err = parseScalar(s)
+		if nil != err {
+			return "", "", err
+		}
+		if strings.Contains(item, ",") {
+			return "", "", fmt.Errorf("array items cannot hold a comma: %q", item)
+		}
+		items = append(items, item)
+		s = strings.TrimSpace(s)
+		if strings.HasPrefix(s, ",") {
+			s = strings.TrimSpace(s[1:])
+		} else if !strings.HasPrefix(s, "]") {
+			return "", "", fmt.Errorf("an array must be on one line and end with ]")
+		}
+	}
+	return strings.Join(items, ","), s[1:], nil
+}
+
+// parseString parses the basic ("...", with escapes) or literal ('...')
+// string at the start of s.
+func parseString(s string) (value string, rest string, err error) {
+	if strings.HasPrefix(s, "'") {
+		end := strings.IndexByte(s[1:], '\'')
+		if end < 0 {
+			return "", "", fmt.Errorf("unterminated string %s", s)
+		}
+		return s[1 : end+1], s[end+2:], nil
+	}
+
+	var sb strings.Builder
+	for ix := 1; ix < len(s); ix++ {
+		c := s[ix]
+		switch {
+		case '"' == c:
+			return sb.String(), s[ix+1:], nil
+		case '\\' != c:
+			sb.WriteByte(c)
+			continue
+		case ix+1 == len(s):
+			return "", "", fmt.Errorf("unterminated string %s", s)
+		}
+		ix++
+		switch s[ix] {
+		case '"', '\\':
+			sb.WriteByte(s[ix])
+		case 'n':
+			sb.WriteByte('\n')
+		case 't':
+			sb.WriteByte('\t')
+		case 'u', 'U':
+			size := 4
+			if 'U' == s[ix] {
+				size = 8
+			}
+			if ix+size >= len(s) {
+				return "", "", fmt.Errorf("short unicode escape in %s", s)
+			}
+			code, err := strconv.ParseUint(s[ix+1:ix+1+size], 16, 32)
+			if nil != err || !utf8.ValidRune(rune(code)) {
+				return "", "", fmt.Errorf("bad unicode escape in %s", s)
+			}
+			sb.WriteRune(rune(code))
+			ix += size
+		default:
+			return "", "", fmt.Errorf("unknown escape \\%c in %s", s[ix], s)
+		}
+	}
+	return "", "", fmt.Errorf("unterminated string %s", s)
+}
+
+// flag value sources, for logFlag (the config files are named by path)
+const (
+	sourceDefault     = "default"
+	sourceCommandLine = "command line"
+	sourceEnvPrefix   = "environment "
+	sourceImplied     = "implied by other flags"
+)
+
+// configKeyAliases are config keys that stand for a flag of another
+// name.
+var configKeyAliases = map[string]string{
+	"background": "background-color",
+}
+
+// envPrefix starts the name of the environment variable for each flag:
+// MADCOLOR_BACKGROUND_COLOR for --background-color.
+const envPrefix = "MADCOLOR_"
+
+// applyConfig sets the flags of fs that were not given on the command
+// line from the config files: the user config, then the project config
+// over it, then the environment (see applyEnv) over both, then the
+// preset chosen by --preset (or a `preset` key or MADCOLOR_PRESET) over
+// the config files, though not over the environment. A preset in the
+// project config hides one of the same name in the user config. It
+// returns where each flag that is not at its default got its value,
+// for logFlag.
+func applyConfig(fs *pflag.FlagSet) (sources map[string]string, err error) {
+	var files []*configFile
+
+	sources = make(map[string]string)
+	fs.Visit(func(f *pflag.Flag) { sources[f.Name] = sourceCommandLine })
+
+	for _, path := range []string{userConfigPath(), projectConfigPath()} {
+		if "" == path || (0 < len(files) && files[0].path == path) {
+			continue
+		}
+		cf, err := loadConfigFile(path)
+		if nil != err {
+			return nil, err
+		}
+		if nil == cf {
+			continue
+		}
+		files = append(files, cf)
+		err = applyConfigValues(fs, sources, cf.values, cf.path)
+		if nil != err {
+			return nil, err
+		}
+	}
+
+	err = applyEnv(fs, sources)
+	if nil != err {
+		return nil, err
+	}
+
+	if !misc.IsStringSet(&FlagPreset) {
+		return sources, nil
+	}
+	var known []string
+	for ix := len(files) - 1; ix >= 0; ix-- {
+		values, ok := files[ix].presets[FlagPreset]
+		if ok {
+			err = applyConfigValues(fs, sources, values,
+				fmt.Sprintf("preset %s in %s", FlagPreset, files[ix].path))
+			if nil != err {
+				return nil, err
+			}
+			return sources, nil
+		}
+		for name := range files[ix].presets {
+			known = append(known, name)
+		}
+	}
+	if 0 == len(known) {
+		return nil, fmt.Errorf("there is no preset %q: no config file defines presets", FlagPreset)
+	}
+	sort.Strings(known)
+	return nil, fmt.Errorf("there is no preset %q; the presets are %s",
+		FlagPreset, strings.Join(slices.Compact(known), ", "))
+}
+
+// applyEnv sets the flags of fs from the MADCOLOR_* environment
+// variables, except those given on the command line. The rest of a
+// variable's name is taken for the flag name with "_" for "-" and
+// normalized like a flag (see wordSepNormalizeFunc), so
+// MADCOLOR_BACKGROUND_COLOR sets --background-color. Empty variables
+// are ignored, as are variables that name no flag (with a note in
+// verbose mode).
+func applyEnv(fs *pflag.FlagSet, sources map[string]string) error {
+	env := os.Environ()
+	sort.Strings(env)
+	for _, kv := range env {
+		key, value, _ := strings.Cut(kv, "=")
+		if !strings.HasPrefix(key, envPrefix) || "" == value {
+			continue
+		}
+		name := string(wordSepNormalizeFunc(fs, strings.ReplaceAll(key[len(envPrefix):], "_", "-")))
+		f := fs.Lookup(name)
+		if nil == f {
+			xLog.Info("ignoring a setting with no flag", "setting", key, "flag", name)
+			continue
+		}
+		err := setConfigFlag(fs, sources, f, value, sourceEnvPrefix+key)
+		if nil != err {
+			return fmt.Errorf("%s: %s", key, err.Error())
+		}
+	}
+	return nil
+}
+
+// applyConfigValues sets the flags of fs named by values, except those
+// given on the command line or by the environment.
+func applyConfigValues(fs *pflag.FlagSet, sources map[string]string,
+	values []configValue, source string) error {
+	for _, cv := range values {
+		name := cv.key
+		if alias, ok := configKeyAliases[name]; ok {
+			name = alias
+		}
+		f := fs.Lookup(name)
+		if nil == f {
+			return fmt.Errorf("%s:%d: there is no setting %s", source, cv.line, cv.key)
+		}
+		if strings.HasPrefix(sources[f.Name], sourceEnvPrefix) {
+			continue
+		}
+		err := setConfigFlag(fs, sources, f, cv.value, fmt.Sprintf("%s:%d", source, cv.line))
+		if nil != err {
+			return fmt.Errorf("%s:%d: %s: %s", source, cv.line, cv.key, err.Error())
+		}
+	}
+	return nil
+}
+
+// setConfigFlag sets flag f of fs to value, and records source in
+// sources as where the value came from, unless it was given on the
+// command line. The value replaces, rather than adds to, the value of a
+// slice flag.
+func setConfigFlag(fs *pflag.FlagSet, sources map[string]string, f *pflag.Flag,
+	value string, source string) error {
+	if sourceCommandLine == sources[f.Name] {
+		return nil
+	}
+	err := fs.Set(f.Name, value)
+	if sv, ok := f.Value.(pflag.SliceValue); ok && nil == err {
+		items := []string{}
+		if "" != value {
+			items = strings.Split(value, ",")
+		}
+		err = sv.Replace(items)
+	}
+	if nil != err {
+		return err
+	}
+	sources[f.Name] = source
+	return nil
+}
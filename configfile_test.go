@@ -0,0 +1,249 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// configDirs writes the user config and a project .madcolor.toml (if
+// their content is not empty) under the isolate dir, and moves into the
+// project directory for the rest of the test. It returns the paths of
+// both files.
+func configDirs(t *testing.T, dir string, user string, project string) (userPath, projectPath string) {
+	t.Helper()
+	userPath = filepath.Join(dir, "config", "madcolor", "config.toml")
+	projectPath = filepath.Join(dir, "project", projectConfigName)
+	for path, content := range map[string]string{userPath: user, projectPath: project} {
+		err := os.MkdirAll(filepath.Dir(path), 0777)
+		if nil == err && "" != content {
+			err = os.WriteFile(path, []byte(content), 0666)
+		}
+		if nil != err {
+			t.Fatal(err)
+		}
+	}
+	chdir(t, filepath.Dir(projectPath))
+	return userPath, projectPath
+}
+
+// applyArgs parses args as colorize flags and applies the config to
+// them, as initFlags does.
+func applyArgs(t *testing.T, args ...string) (map[string]string, error) {
+	t.Helper()
+	fs := newColorizeFlags()
+	err := fs.Parse(args)
+	if nil != err {
+		t.Fatalf("%v: %v", args, err)
+	}
+	nFlags = fs
+	return applyConfig(fs)
+}
+
+// TestConfigPrecedence checks which of the command line, the
+// environment, a preset, the project config and the user config each
+// flag is taken from, and that the source recorded for it says so. In
+// the sources, $USER and $PROJECT stand for the paths of the files.
+func TestConfigPrecedence(t *testing.T) {
+	cases := []struct {
+		name    string
+		user    string
+		project string
+		env     map[string]string
+		args    []string
+		want    map[string]string // flag: value
+		sources map[string]string // flag: source
+	}{
+		{
+			name: "defaults",
+			want: map[string]string{"salt": "", "mode": modeRandom},
+		},
+		{
+			name:    "user config",
+			user:    "salt = \"user\"\nmode = \"hash\"\n",
+			want:    map[string]string{"salt": "user", "mode": modeHash},
+			sources: map[string]string{"salt": "$USER:1", "mode": "$USER:2"},
+		},
+		{
+			name:    "project over user",
+			user:    "salt = \"user\"\nmode = \"hash\"\n",
+			project: "# project\nsalt = \"project\"\n",
+			want:    map[string]string{"salt": "project", "mode": modeHash},
+			sources: map[string]string{"salt": "$PROJECT:2", "mode": "$USER:2"},
+		},
+		{
+			name:    "environment over files",
+			user:    "salt = \"user\"\n",
+			project: "unit = \"word\"\n",
+			env:     map[string]string{"MADCOLOR_SALT": "env", "MADCOLOR_UNIT": "glyph"},
+			want:    map[string]string{"salt": "env", "unit": unitGlyph},
+			sources: map[string]string{"salt": "environment MADCOLOR_SALT", "unit": "environment MADCOLOR_UNIT"},
+		},
+		{
+			name:    "command line over all",
+			user:    "salt = \"user\"\n",
+			project: "salt = \"project\"\n",
+			env:     map[string]string{"MADCOLOR_SALT": "env"},
+			args:    []string{"--salt", "flag"},
+			want:    map[string]string{"salt": "flag"},
+			sources: map[string]string{"salt": sourceCommandLine},
+		},
+		{
+			name:    "preset over files",
+			user:    "salt = \"user\"\n[preset.dark]\nsalt = \"dark\"\nbackground = \"navy\"\n",
+			project: "background = \"white\"\n",
+			args:    []string{"--preset", "dark"},
+			want:    map[string]string{"salt": "dark", "background-color": "navy"},
+			sources: map[string]string{"salt": "preset dark in $USER:3", "background-color": "preset dark in $USER:4"},
+		},
+		{
+			name: "preset under environment",
+			user: "[preset.dark]\nsalt = \"dark\"\nmode = \"hash\"\n",
+			env:  map[string]string{"MADCOLOR_SALT": "env"},
+			args: []string{"--preset", "dark"},
+			want: map[string]string{"salt": "env", "mode": modeHash},
+		},
+		{
+			name:    "preset key",
+			user:    "preset = \"dark\"\n[preset.dark]\nsalt = \"dark\"\n",
+			want:    map[string]string{"salt": "dark"},
+			sources: map[string]string{"preset": "$USER:1", "salt": "preset dark in $USER:3"},
+		},
+		{
+			name:    "project preset hides user preset",
+			user:    "[preset.dark]\nsalt = \"user\"\nmode = \"hash\"\n",
+			project: "[preset.dark]\nsalt = \"project\"\n",
+			env:     map[string]string{"MADCOLOR_PRESET": "dark"},
+			want:    map[string]string{"salt": "project", "mode": modeRandom},
+			sources: map[string]string{"salt": "preset dark in $PROJECT:2"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			dir := isolate(t)
+			userPath, projectPath := configDirs(t, dir, c.user, c.project)
+			for key, value := range c.env {
+				t.Setenv(key, value)
+			}
+
+			sources, err := applyArgs(t, c.args...)
+			if nil != err {
+				t.Fatalf("applyConfig: %v", err)
+			}
+			for name, want := range c.want {
+				if got := nFlags.Lookup(name).Value.String(); want != got {
+					t.Errorf("--%s is %q, want %q", name, got, want)
+				}
+			}
+			for name, want := range c.sources {
+				want = strings.NewReplacer("$USER", userPath, "$PROJECT", projectPath).Replace(want)
+				if got := sources[name]; want != got {
+					t.Errorf("--%s came from %q, want %q", name, got, want)
+				}
+			}
+		})
+	}
+}
+
+// TestConfigErrors checks the errors for an unknown key, a bad value and
+// an unknown preset, which name the file and line or the presets there
+// are.
+func TestConfigErrors(t *testing.T) {
+	cases := []struct {
+		name    string
+		user    string
+		project string
+		args    []string
+		want    string // in the error, with $USER and $PROJECT for the paths
+	}{
+		{"unknown key", "salt = \"x\"\nnosuchkey = 1\n", "", nil, "$USER:2: there is no setting nosuchkey"},
+		{"bad value", "", "invent = \"maybe\"\n", nil, "$PROJECT:1: invent:"},
+		{"unknown key in a preset", "[preset.p]\nbogus = true\n", "", []string{"--preset", "p"},
+			"preset p in $USER:2: there is no setting bogus"},
+		{"unknown preset", "[preset.b]\n[preset.a]\n", "[preset.a]\n", []string{"--preset", "c"},
+			`there is no preset "c"; the presets are a, b`},
+		{"no presets", "", "", []string{"--preset", "c"},
+			`there is no preset "c": no config file defines presets`},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			dir := isolate(t)
+			userPath, projectPath := configDirs(t, dir, c.user, c.project)
+			want := strings.NewReplacer("$USER", userPath, "$PROJECT", projectPath).Replace(c.want)
+
+			_, err := applyArgs(t, c.args...)
+			if nil == err || !strings.Contains(err.Error(), want) {
+				t.Errorf("got %v, want an error with %q", err, want)
+			}
+		})
+	}
+}
+
+// TestFlagSourcesPerRun checks that a flag given on the command line of
+// one run does not keep the config from setting it in the next.
+func TestFlagSourcesPerRun(t *testing.T) {
+	dir := isolate(t)
+	configDirs(t, dir, "salt = \"user\"\n", "")
+
+	_, err := applyArgs(t, "--salt", "flag")
+	if nil != err {
+		t.Fatal(err)
+	}
+	sources, err := applyArgs(t)
+	if nil != err {
+		t.Fatal(err)
+	}
+	if got := nFlags.Lookup("salt").Value.String(); "user" != got {
+		t.Errorf("--salt is %q in the second run (from %q), want the config's", got, sources["salt"])
+	}
+}
+
+// TestApplyEnv checks the MADCOLOR_* variables: their names are
+// normalized like flag names, empty ones and ones that name no flag are
+// skipped, a bad value gets the message the flag would give, and they
+// win over a preset.
+func TestApplyEnv(t *testing.T) {
+	dir := isolate(t)
+	configDirs(t, dir, "[preset.p]\nsalt = \"preset\"\nunit = \"word\"\n", "")
+	t.Setenv("MADCOLOR_BACKGROUND_COLOR", "navy")
+	t.Setenv("MADCOLOR_Invent", "true")
+	t.Setenv("MADCOLOR_SALT", "env")
+	t.Setenv("MADCOLOR_UNIT", "")
+	t.Setenv("MADCOLOR_NO_SUCH_FLAG", "x")
+
+	sources, err := applyArgs(t, "--preset", "p")
+	if nil != err {
+		t.Fatalf("applyConfig: %v", err)
+	}
+	want := map[string]string{"background-color": "navy", "invent": "true", "salt": "env", "unit": unitWord}
+	for name, value := range want {
+		if got := nFlags.Lookup(name).Value.String(); value != got {
+			t.Errorf("--%s is %q (from %q), want %q", name, got, sources[name], value)
+		}
+	}
+	if "environment MADCOLOR_Invent" != sources["invent"] {
+		t.Errorf("--invent came from %q", sources["invent"])
+	}
+
+	for _, c := range []struct{ flag, value string }{
+		{"invent", "maybe"},
+		{"contrast", "bogus"},
+		{"distance", "far"},
+	} {
+		fs := newColorizeFlags()
+		flagErr := fs.Parse([]string{"--" + c.flag + "=" + c.value})
+		if nil == flagErr {
+			t.Fatalf("--%s %s was accepted", c.flag, c.value)
+		}
+		key := envPrefix + strings.ToUpper(c.flag)
+		t.Setenv(key, c.value)
+		_, err := applyArgs(t)
+		if nil == err || key+": "+flagErr.Error() != err.Error() {
+			t.Errorf("%s=%s gave %v, want the flag's message %q", key, c.value, err, flagErr)
+		}
+		t.Setenv(key, "")
+	}
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"math"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	htmlColor "madcolor/htmlcolor"
+)
+
+var FlagContrastJSON bool
+var FlagContrastLevel []string
+var FlagContrastAPCA float64
+
+// contrastCheck is one color pair checked by `madcolor contrast`, with
+// the requested levels it fails.
+type contrastCheck struct {
+	contrastResponse
+	Failed []string `json:"failed,omitempty"`
+}
+
+// newContrastFlags returns the flag set of the contrast command.
+func newContrastFlags() *commandFlags {
+	cFlags := newCommandFlags("contrast")
+
+	cFlags.BoolVarP(&FlagContrastJSON, "json", "j", false,
+		"Write the results as JSON")
+	cFlags.StringSliceVarP(&FlagContrastLevel, "level", "l", nil,
+		fmt.Sprintf("WCAG level(s) every pair must meet, from %v", wcagLevelNames()))
+	cFlags.Float64VarP(&FlagContrastAPCA, "apca", "", 0,
+		"Smallest APCA contrast |Lc| every pair must meet (0 for none)")
+	completeFlag(cFlags.FlagSet, "level", completeChoice, wcagLevelNames()...)
+	return cFlags
+}
+
+// wcagLevelNames returns the names of the WCAG levels.
+func wcagLevelNames() (names []string) {
+	for _, level := range htmlColor.WCAGLevels {
+		names = append(names, level.Name)
+	}
+	return names
+}
+
+// runContrast implements `madcolor contrast <fg> <bg> [<fg> <bg> ...]`:
+// the WCAG ratio and levels, APCA contrast, distances and luminances of
+// each foreground/background pair. It returns an ErrCheckFailed when a
+// pair fails a level asked for with --level or --apca.
+func runContrast(args []string) error {
+	var levels []htmlColor.WCAGLevel
+
+	cFlags := newContrastFlags()
+
+	args, err := parseCommandFlags(cFlags, args)
+	if nil == err {
+		err = requireArgs(cFlags, args, 2, -1)
+	}
+	if nil != err {
+		return err
+	}
+	if 0 != len(args)%2 {
+		commandUsage(cFlags)
+		return usageErrorf("contrast takes pairs of colors, not %d argument(s)", len(args))
+	}
+	for _, name := range FlagContrastLevel {
+		level, ok := htmlColor.ParseWCAGLevel(name)
+		if !ok {
+			return usageErrorf("--level must be from %v, not %q", wcagLevelNames(), name)
+		}
+		levels = append(levels, level)
+	}
+
+	checks := make([]contrastCheck, 0, len(args)/2)
+	failed := false
+	for ix := 0; ix < len(args); ix += 2 {
+		resp, err := contrastResult(args[ix], args[ix+1])
+		if nil != err {
+			return err
+		}
+		check := contrastCheck{contrastResponse: resp}
+		for _, level := range levels {
+			if !resp.WCAG[level.Name] {
+				check.Failed = append(check.Failed, level.Name)
+			}
+		}
+		if 0 < FlagContrastAPCA && math.Abs(resp.APCA) < FlagContrastAPCA {
+			check.Failed = append(check.Failed, fmt.Sprintf("APCA %g", FlagContrastAPCA))
+		}
+		failed = failed || 0 < len(check.Failed)
+		checks = append(checks, check)
+	}
+
+	if FlagContrastJSON {
+		err = printJSON(checks)
+	} else {
+		err = printContrast(checks)
+	}
+	if nil == err && failed {
+		err = fmt.Errorf("%w: a color pair failed a requested contrast level", ErrCheckFailed)
+	}
+	return err
+}
+
+// printContrast writes checks as a table, one pair per row.
+func printContrast(checks []contrastCheck) error {
+	out := bufio.NewWriter(os.Stdout)
+	tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', tabwriter.AlignRight)
+
+	passFail := func(ok bool) string {
+		if ok {
+			return "pass"
+		}
+		return "fail"
+	}
+
+	header := []string{"fg", "bg", "ratio"}
+	for _, level := range htmlColor.WCAGLevels {
+		header = append(header, level.Name)
+	}
+	header = append(header, "APCA Lc", "distance", "ΔE", "fg lum", "bg lum", "result")
+	_, _ = fmt.Fprintln(tw, strings.Join(header, "\t")+"\t")
+
+	for _, c := range checks {
+		row := []string{c.Foreground, c.Background, fmt.Sprintf("%.2f:1", c.Ratio)}
+		for _, level := range htmlColor.WCAGLevels {
+			row = append(row, passFail(c.WCAG[level.Name]))
+		}
+		result := "ok"
+		if 0 < len(c.Failed) {
+			result = "FAIL " + strings.Join(c.Failed, ",")
+		}
+		row = append(row,
+			fmt.Sprintf("%.1f", c.APCA),
+			fmt.Sprintf("%.1f", c.Distance),
+			fmt.Sprintf("%.1f", c.DeltaE),
+			fmt.Sprintf("%.4f", c.FgLuminance),
+			fmt.Sprintf("%.4f", c.BgLuminance),
+			result)
+		_, _ = fmt.Fprintln(tw, strings.Join(row, "\t")+"\t")
+	}
+	_ = tw.Flush() // an error is out's, which flushOutput reports
+	return flushOutput(out)
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	htmlColor "madcolor/htmlcolor"
+)
+
+var FlagConvertJSON bool
+var FlagConvertNearest bool
+
+// convertResponse is one color in each notation madcolor knows.
+type convertResponse struct {
+	Hex     string           `json:"hex"`
+	RGB     string           `json:"rgb"`
+	HSL     string           `json:"hsl"`
+	Names   []string         `json:"names"`
+	Nearest *nearestResponse `json:"nearest,omitempty"`
+}
+
+// convertResult converts a color given in any syntax --background-color
+// accepts. Names lists the exact names of the color; when there are none,
+// or when nearest is set, Nearest is the perceptually closest named color.
+// An unknown color is an error wrapping htmlColor.ErrUnknownColor.
+func convertResult(in string, nearest bool) (resp convertResponse, err error) {
+	hex, err := htmlColor.ParseColor(in)
+	if nil != err {
+		return resp, err
+	}
+	r, g, b, err := htmlColor.RGB(hex)
+	if nil != err {
+		return resp, err
+	}
+	h, s, l, err := htmlColor.HSL(hex)
+	if nil != err {
+		return resp, err
+	}
+	resp = convertResponse{
+		Hex:   hex,
+		RGB:   fmt.Sprintf("rgb(%d, %d, %d)", r, g, b),
+		HSL:   fmt.Sprintf("hsl(%.0f, %.0f%%, %.0f%%)", h, s*100.0, l*100.0),
+		Names: htmlColor.NamesFor(hex),
+	}
+	if nil == resp.Names {
+		resp.Names = []string{}
+		nearest = true
+	}
+	if nearest {
+		n, err := nearestResult(hex)
+		if nil != err {
+			return resp, err
+		}
+		resp.Nearest = &n
+	}
+	return resp, nil
+}
+
+// newConvertFlags returns the flag set of the convert command.
+func newConvertFlags() *commandFlags {
+	cFlags := newCommandFlags("convert")
+
+	cFlags.BoolVarP(&FlagConvertJSON, "json", "j", false,
+		"Write the result as JSON")
+	cFlags.BoolVarP(&FlagConvertNearest, "nearest", "n", false,
+		"Show the nearest named color and its distance (ΔE) even when the color has a name")
+	return cFlags
+}
+
+// runConvert implements `madcolor convert <color>`.
+func runConvert(args []string) error {
+	cFlags := newConvertFlags()
+
+	args, err := parseCommandFlags(cFlags, args)
+	if nil == err {
+		err = requireArgs(cFlags, args, 1, 1)
+	}
+	if nil != err {
+		return err
+	}
+
+	resp, err := convertResult(args[0], FlagConvertNearest)
+	if nil != err {
+		return err
+	}
+
+	if FlagConvertJSON {
+		return printJSON(resp)
+	}
+	fmt.Printf("hex      %s\nrgb      %s\nhsl      %s\n", resp.Hex, resp.RGB, resp.HSL)
+	if 0 < len(resp.Names) {
+		fmt.Printf("names    %s\n", strings.Join(resp.Names, ", "))
+	}
+	if nil != resp.Nearest {
+		fmt.Printf("nearest  %s %s (ΔE %.1f)\n", htmlColor.DisplayName(resp.Nearest.Name), resp.Nearest.Hex, resp.Nearest.Distance)
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	htmlColor "madcolor/htmlcolor"
+)
+
+// The errors the commands return, wrapped with the details. Only main
+// turns them into an exit code (see exitCode); the htmlcolor errors
+// (htmlColor.ErrUnknownColor, ...) pass through unchanged.
+var (
+	// ErrUsage is a bad flag, argument or option value.
+	ErrUsage = errors.New("bad usage")
+
+	// ErrInput is an input that could not be opened or read.
+	ErrInput = errors.New("could not read the input")
+
+	// ErrOutput is an output that could not be created or written.
+	ErrOutput = errors.New("could not write the output")
+
+	// ErrCheckFailed is a check that ran and failed, such as a color
+	// pair below a --level of `madcolor contrast`.
+	ErrCheckFailed = errors.New("check failed")
+
+	// errHelp is returned once --help has shown the usage. It is not a
+	// failure.
+	errHelp = errors.New("help shown")
+)
+
+// usageErrorf returns an error wrapping ErrUsage, with a message
+// formatted as fmt.Errorf formats it.
+func usageErrorf(format string, a ...any) error {
+	return fmt.Errorf("%w: %w", ErrUsage, fmt.Errorf(format, a...))
+}
+
+// flushOutput flushes out, a buffered stdout, wrapping a failure as an
+// ErrOutput.
+func flushOutput(out *bufio.Writer) error {
+	err := out.Flush()
+	if nil != err {
+		return fmt.Errorf("%w: %w", ErrOutput, err)
+	}
+	return nil
+}
+
+// The exit codes. They are part of the interface, documented in the
+// readme: scripts tell the failures apart by them, so a code is never
+// reused for another kind of error.
+const (
+	exitOK            = 0
+	exitCheckFailed   = 1
+	exitUsage         = 2
+	exitUnknownColor  = 3
+	exitInput         = 4
+	exitOutput        = 5
+	exitUnsatisfiable = 6
+	exitError         = 7
+)
+
+// error formats (see --error-format)
+const (
+	errorFormatText = "text"
+	errorFormatJSON = "json"
+)
+
+// errorFormats lists the values --error-format accepts.
+var errorFormats = []string{errorFormatText, errorFormatJSON}
+
+var FlagErrorFormat string
+
+// errorKind is one row of the exit code table: the errors wrapping err
+// exit with code, and are reported as kind by --error-format json.
+type errorKind struct {
+	err  error
+	code int
+	kind string
+}
+
+// errorKinds is the exit code table, most specific first: an unknown
+// color given to a flag is an unknown color rather than bad usage.
+// Errors matching no row are exitError, "error".
+var errorKinds = []errorKind{
+	{ErrCheckFailed, exitCheckFailed, "check-failed"},
+	{htmlColor.ErrUnknownColor, exitUnknownColor, "unknown-color"},
+	{ErrUsage, exitUsage, "usage"},
+	{ErrInput, exitInput, "input"},
+	{ErrOutput, exitOutput, "output"},
+	{htmlColor.ErrContrastUnsatisfiable, exitUnsatisfiable, "contrast-unsatisfiable"},
+}
+
+// classify returns the exit code and kind of the error a command
+// returned (see errorKinds). nil and errHelp are exitOK.
+func classify(err error) (code int, kind string) {
+	if nil == err || errors.Is(err, errHelp) {
+		return exitOK, ""
+	}
+	for _, k := range errorKinds {
+		if errors.Is(err, k.err) {
+			return k.code, k.kind
+		}
+	}
+	return exitError, "error"
+}
+
+// exitCode returns the exit code for the error a command returned.
+func exitCode(err error) int {
+	code, _ := classify(err)
+	return code
+}
+
+// errorReport is the object --error-format json writes to stderr.
+type errorReport struct {
+	Kind     string `json:"kind"`
+	ExitCode int    `json:"exit-code"`
+	Message  string `json:"message"`
+}
+
+// writeErrorJSON writes err to w as an errorReport, on one line.
+func writeErrorJSON(w io.Writer, err error) error {
+	code, kind := classify(err)
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	return enc.Encode(errorReport{Kind: kind, ExitCode: code, Message: err.Error()})
+}
+
+// errorFormat returns the --error-format to report an error in. A value
+// on the command line is looked for in args directly, so that it holds
+// even when the flags could not be parsed; otherwise it is the flag's
+// value (which may come from the config or the environment).
+func errorFormat(args []string) string {
+	format := FlagErrorFormat
+	for ix := 0; ix < len(args); ix++ {
+		switch {
+		case "--" == args[ix]:
+			return format
+		case strings.HasPrefix(args[ix], "--error-format="):
+			format = strings.TrimPrefix(args[ix], "--error-format=")
+		case "--error-format" == args[ix] && ix+1 < len(args):
+			ix++
+			format = args[ix]
+		}
+	}
+	return format
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	htmlColor "madcolor/htmlcolor"
+)
+
+// TestExitCodes runs a command line for each row of the exit code
+// table, and checks the code and kind of the error it returns.
+func TestExitCodes(t *testing.T) {
+	dir := isolate(t)
+	input := filepath.Join(dir, "input.html")
+	err := os.WriteFile(input, []byte(`<span style="color: #000000;">x</span>`), 0666)
+	if nil != err {
+		t.Fatal(err)
+	}
+	colorize := []string{"colorize", "--text", "x", "--buff=false", "--nopaste=false",
+		"--clipboard-backend", "file", "--clipboard-file", filepath.Join(dir, "clipboard")}
+
+	cases := []struct {
+		name string
+		args []string
+		code int
+		kind string
+	}{
+		{"success", []string{"convert", "red"}, exitOK, ""},
+		{"help", []string{"convert", "--help"}, exitOK, ""},
+		{"check failed", []string{"contrast", "white", "white", "--level", "AA"}, exitCheckFailed, "check-failed"},
+		{"lint clean", []string{"palette", "lint", "--palette-source", "css-basic"}, exitOK, ""},
+		{"lint duplicates", []string{"palette", "lint"}, exitCheckFailed, "check-failed"},
+		{"unknown command", []string{"help", "nosuchcommand"}, exitUsage, "usage"},
+		{"stray argument", append([]string{"somefile"}, colorize[1:]...), exitOK, ""},
+		{"bad flag", []string{"convert", "--bogus", "red"}, exitUsage, "usage"},
+		{"bad argument count", []string{"convert"}, exitUsage, "usage"},
+		{"bad option value", append(colorize, "--unit", "line"), exitUsage, "usage"},
+		{"bad class prefix", append(colorize, "--class-prefix", "1 x"), exitUsage, "usage"},
+		{"unknown color", []string{"convert", "nosuchcolor"}, exitUnknownColor, "unknown-color"},
+		{"unknown color in a flag", append(colorize, "--background-color", "nosuchcolor"),
+			exitUnknownColor, "unknown-color"},
+		{"input", []string{"strip", filepath.Join(dir, "missing.html")}, exitInput, "input"},
+		{"output", []string{"strip", "--output", filepath.Join(dir, "missing", "out.txt"), input},
+			exitOutput, "output"},
+		{"unsatisfiable", append(colorize, "--background-color", "gray", "--contrast", "95",
+			"--on-unsatisfiable", "fail"), exitUnsatisfiable, "contrast-unsatisfiable"},
+		{"other", []string{"serve", "--listen", "127.0.0.1:99999"}, exitError, "error"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := run(t, c.args...)
+			code, kind := classify(err)
+			if c.code != code || c.kind != kind {
+				t.Errorf("%v gave %d %q (%v), want %d %q", c.args, code, kind, err, c.code, c.kind)
+			}
+			if code != exitCode(err) {
+				t.Errorf("exitCode gave %d, classify %d", exitCode(err), code)
+			}
+		})
+	}
+}
+
+// TestClassify checks the order of the exit code table: wrapped errors
+// keep their kind, an unknown color given to a flag is not bad usage,
+// and anything unknown is exitError.
+func TestClassify(t *testing.T) {
+	cases := []struct {
+		err  error
+		code int
+	}{
+		{nil, exitOK},
+		{errHelp, exitOK},
+		{fmt.Errorf("wrapped: %w", ErrCheckFailed), exitCheckFailed},
+		{usageErrorf("--x: %w", fmt.Errorf("%w %q", htmlColor.ErrUnknownColor, "x")), exitUnknownColor},
+		{usageErrorf("bad"), exitUsage},
+		{fmt.Errorf("%w: x", ErrInput), exitInput},
+		{fmt.Errorf("%w: x", ErrOutput), exitOutput},
+		{fmt.Errorf("%w: x", htmlColor.ErrContrastUnsatisfiable), exitUnsatisfiable},
+		{fmt.Errorf("%w: x", htmlColor.ErrInvalidHex), exitError},
+		{errors.New("anything else"), exitError},
+	}
+	for _, c := range cases {
+		if code, _ := classify(c.err); c.code != code {
+			t.Errorf("classify(%v) = %d, want %d", c.err, code, c.code)
+		}
+	}
+}
+
+// TestErrorFormatJSON checks the object --error-format json writes,
+// including for a flag that could not be parsed, where the flag itself
+// may come after the bad one.
+func TestErrorFormatJSON(t *testing.T) {
+	isolate(t)
+	cases := []struct {
+		args []string
+		code int
+		kind string
+	}{
+		{[]string{"convert", "nosuchcolor", "--error-format", "json"}, exitUnknownColor, "unknown-color"},
+		{[]string{"convert", "--error-format=json", "--bogus", "red"}, exitUsage, "usage"},
+		{[]string{"convert", "--bogus", "--error-format", "json", "red"}, exitUsage, "usage"},
+		{[]string{"contrast", "white", "white", "--level", "AAA", "--error-format", "json"},
+			exitCheckFailed, "check-failed"},
+	}
+
+	for _, c := range cases {
+		var stderr bytes.Buffer
+		var report errorReport
+
+		err := run(t, c.args...)
+		format := errorFormat(c.args)
+		if errorFormatJSON != format {
+			t.Fatalf("errorFormat(%v) = %q", c.args, format)
+		}
+		reportError(&stderr, err, format)
+
+		dec := json.NewDecoder(&stderr)
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&report); nil != err {
+			t.Fatalf("%v: could not decode %q: %v", c.args, stderr.String(), err)
+		}
+		if c.code != report.ExitCode || c.kind != report.Kind || "" == report.Message {
+			t.Errorf("%v reported %+v, want %d %q", c.args, report, c.code, c.kind)
+		}
+		if dec.More() {
+			t.Errorf("%v: more than one object on stderr", c.args)
+		}
+	}
+
+	FlagErrorFormat = errorFormatText // the last command left it json
+	if format := errorFormat([]string{"convert", "--", "--error-format", "json"}); errorFormatJSON == format {
+		t.Errorf("--error-format after -- was taken for the flag")
+	}
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// isolate keeps a test away from the user's config, environment, log and
+// clipboard: the XDG directories point into a temporary directory, which
+// it returns, and every MADCOLOR_* variable is emptied (applyEnv skips
+// empty ones).
+func isolate(t *testing.T) (dir string) {
+	t.Helper()
+	dir = t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(dir, "config"))
+	t.Setenv("XDG_STATE_HOME", filepath.Join(dir, "state"))
+	for _, kv := range os.Environ() {
+		if key, _, _ := strings.Cut(kv, "="); strings.HasPrefix(key, envPrefix) {
+			t.Setenv(key, "")
+		}
+	}
+	return dir
+}
+
+// quietArgs are added to every command a test runs: no log file, and no
+// log on stderr.
+var quietArgs = []string{"--log-file", "none", "--quiet"}
+
+// run runs a madcolor command line, as main would, and returns its
+// error.
+func run(t *testing.T, args ...string) error {
+	t.Helper()
+	return runCommand(append(args, quietArgs...))
+}
+
+// readFile returns the content of a file the test expects to exist.
+func readFile(t *testing.T, path string) string {
+	t.Helper()
+	b, err := os.ReadFile(path)
+	if nil != err {
+		t.Fatalf("could not read %s: %v", path, err)
+	}
+	return string(b)
+}
+
+// chdir moves into dir for the rest of the test.
+func chdir(t *testing.T, dir string) {
+	t.Helper()
+	wd, err := os.Getwd()
+	if nil == err {
+		err = os.Chdir(dir)
+	}
+	if nil != err {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = os.Chdir(wd) })
+}
@@ -3,14 +3,18 @@ package htmlcolors
 import (
 	"bufio"
 	"crypto/rand"
+	"encoding/binary"
 	"fmt"
+	"io"
 	"math"
 	"math/big"
-	"os"
+	mrand "math/rand/v2"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
+	"unicode"
 
 	"madcolor/misc"
 )
@@ -18,14 +22,17 @@ import (
 // var modeDebug = false
 var htmlColorArrayLength *big.Int
 
+// paletteDuplicates are the names buildPalette left out of the palette.
+var paletteDuplicates []Duplicate
+
 // regExpHexB match 2-digit hex byte (only)
 const regExpHexB = "[\\da-fA-F]{2}"
 
 // regExpHex6 match 6-digit hex value (submatch the hex)
-const regExpHex6 = "#?([\\da-fA-F]{6})"
+const regExpHex6 = "^#?([\\da-fA-F]{6})$"
 
 // regExpHex3 match 3-digit hex value, submatch each 4-byte digit
-const regExpHex3 = "#?([\\da-fA-F]{3})"
+const regExpHex3 = "^#?([\\da-fA-F])([\\da-fA-F])([\\da-fA-F])$"
 
 // rxHexB: match 2-digit hex byte
 var rxHexB *regexp.Regexp
@@ -37,44 +44,193 @@ var rxHex6 *regexp.Regexp
 var rxHex3 *regexp.Regexp
 
 type htmlColor struct {
-	name string
-	hex  string
+	name    string
+	display string
+	hex     string
+	source  string
 }
 
+// htmlColorArray is the palette colors are chosen from: the colors of
+// colorTable from the selected sources (see SetPaletteSources), one
+// name per hex value.
 var htmlColorArray []htmlColor
 
+// ColorNames maps every name and alias of colorTable to its hex value.
+var ColorNames = colorNames()
+
+// nameIndex maps every name and alias of colorTable to the index of its
+// entry, and looseIndex does the same by looseKey, for the spellings
+// StringToColor and DisplayName accept.
+var nameIndex map[string]int
+var looseIndex map[string]int
+
+// colorNames builds ColorNames from colorTable, panicking on a name
+// or alias that appears twice.
+func colorNames() map[string]string {
+	names := make(map[string]string, 2*len(colorTable))
+	for _, c := range colorTable {
+		for _, name := range append([]string{c.Name}, c.Aliases...) {
+			if _, dup := names[name]; dup {
+				panic("huh? color name " + name + " appears twice in the color table")
+			}
+			names[name] = c.Hex
+		}
+	}
+	return names
+}
+
+// lockedReader serializes reads, so that the buffered random source
+// can be shared by concurrent requests (see madcolor serve).
+type lockedReader struct {
+	mu sync.Mutex
+	r  *bufio.Reader
+}
+
+func (l *lockedReader) Read(p []byte) (n int, err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.r.Read(p)
+}
+
 // buffRandReader buffers the many small calls to
-// crypto/Rand.reader.
-var buffRandReader = bufio.NewReader(rand.Reader)
+// crypto/Rand.reader, or reads the seeded source SetSeed installs.
+var buffRandReader = &lockedReader{r: bufio.NewReader(rand.Reader)}
+
+// seededReader is a reproducible stream of random bytes, for SetSeed.
+type seededReader struct {
+	src *mrand.PCG
+}
+
+func (s seededReader) Read(p []byte) (n int, err error) {
+	var word [8]byte
+	for n < len(p) {
+		binary.LittleEndian.PutUint64(word[:], s.src.Uint64())
+		n += copy(p[n:], word[:])
+	}
+	return n, nil
+}
+
+// SetSeed makes the random choices of RandomColor, RandColor,
+// RandNamedColor and InventColor reproducible: the same seed gives the
+// same colors, in the same order, on every run and machine (for the same
+// palette). An empty seed restores crypto/rand. The seed is hashed as
+// tokenHash hashes a token, so any string will do.
+func SetSeed(seed string) {
+	r := bufio.NewReader(rand.Reader)
+	if "" != seed {
+		r = bufio.NewReader(seededReader{src: mrand.NewPCG(tokenHash("seed", seed, 0),
+			tokenHash("seed", seed, 1))})
+	}
+	buffRandReader.mu.Lock()
+	defer buffRandReader.mu.Unlock()
+	buffRandReader.r = r
+}
 
 func init() {
-	htmlColorArrayLength = big.NewInt(int64(len(ColorNames)))
 	rxHexB = regexp.MustCompile(regExpHexB)
 	rxHex6 = regexp.MustCompile(regExpHex6)
 	rxHex3 = regexp.MustCompile(regExpHex3)
-	htmlColorArray = make([]htmlColor, 0, len(ColorNames))
-	invertArray := make(map[string]string, len(ColorNames))
 	// buffRandReader = bufio.NewReader(rand.Reader)
 
-	var colorWait sync.WaitGroup
-	colorWait.Add(1)
-	colorChan := make(chan string, 32)
-	go misc.RecordString("", "duplicate_colors.txt", colorChan, colorWait.Done)
-	defer colorWait.Wait()
-	defer close(colorChan)
-
-	// this fills the array randomly. Doesn't matter for our purposes.
-	for key, val := range ColorNames {
-		dup, ok := invertArray[val]
-		if ok { // report & ignore duplicate colors
-			colorChan <- fmt.Sprintf("duplicate color hex %s has names %s and %s\n",
-				val, dup, key)
+	nameIndex = make(map[string]int, 2*len(colorTable))
+	looseIndex = make(map[string]int, 2*len(colorTable))
+	for ix, c := range colorTable {
+		for _, name := range append([]string{c.Name}, c.Aliases...) {
+			nameIndex[name] = ix
+			key := looseKey(name)
+			prev, taken := looseIndex[key]
+			// should two colors share a loose spelling, it means the
+			// CSS one, else the first in name order.
+			if !taken || (isCSS(c.Source) && !isCSS(colorTable[prev].Source)) ||
+				(isCSS(c.Source) == isCSS(colorTable[prev].Source) && c.Name < colorTable[prev].Name) {
+				looseIndex[key] = ix
+			}
+		}
+	}
+
+	setPalette(buildPalette(func(ColorInfo) bool { return true }))
+}
+
+// looseKey lowercases a color name and drops the spaces, hyphens and
+// apostrophes, so that "Big Dip O'Ruby", "big-dip-oruby" and
+// "big dip o’ruby" are the same.
+func looseKey(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '\t', '-', '\'', '’', '‘':
+			return -1
+		}
+		return unicode.ToLower(r)
+	}, name)
+}
+
+// isCSS reports whether a source is one of the CSS named colors.
+func isCSS(source string) bool {
+	return SourceCSS == source || SourceCSSBasic == source
+}
+
+// lookupName returns the colorTable entry for a color name or alias, in
+// any spelling looseKey accepts.
+func lookupName(name string) (c ColorInfo, ok bool) {
+	name = strings.TrimSpace(name)
+	ix, ok := nameIndex[strings.ToLower(name)]
+	if !ok {
+		ix, ok = looseIndex[looseKey(name)]
+	}
+	if !ok {
+		return ColorInfo{}, false
+	}
+	return colorTable[ix], true
+}
+
+// DisplayName returns the display name of a color name or alias, such
+// as "Blanched Almond" for "blanchedalmond". Anything that is not a
+// color name, such as a hex value, is returned as it is.
+func DisplayName(name string) string {
+	c, ok := lookupName(name)
+	if !ok {
+		return name
+	}
+	return c.Display
+}
+
+// sortedTable returns colorTable in name order, so that deterministic
+// selection (see HashColor) picks the same color on every run and
+// every machine.
+func sortedTable() []ColorInfo {
+	table := append([]ColorInfo(nil), colorTable...)
+	sort.Slice(table, func(i, j int) bool { return table[i].Name < table[j].Name })
+	return table
+}
+
+// buildPalette returns the palette of the colors of colorTable that
+// keep accepts, in name order. Where several names share a hex value,
+// only the first is kept; the others are returned as dups.
+func buildPalette(keep func(ColorInfo) bool) (palette []htmlColor, dups []Duplicate) {
+	seen := make(map[string]string, len(colorTable))
+	palette = make([]htmlColor, 0, len(colorTable))
+	for _, c := range sortedTable() {
+		if !keep(c) {
+			continue
+		}
+		kept, ok := seen[c.Hex]
+		if ok {
+			dups = append(dups, Duplicate{Hex: c.Hex, Name: kept, Dropped: c.Name})
 			continue
 		}
-		invertArray[val] = key
-		tmp := htmlColor{name: key, hex: val}
-		htmlColorArray = append(htmlColorArray, tmp)
+		seen[c.Hex] = c.Name
+		palette = append(palette, htmlColor{name: c.Name, display: c.Display, hex: c.Hex, source: c.Source})
 	}
+	return palette, dups
+}
+
+// setPalette makes palette the one colors are chosen from, and dups
+// the names it left out (see Duplicates).
+func setPalette(palette []htmlColor, dups []Duplicate) {
+	htmlColorArray = palette
+	htmlColorArrayLength = big.NewInt(int64(len(palette)))
+	paletteDuplicates = dups
+	resetKDTree()
 }
 
 /*****************************/
@@ -83,14 +239,15 @@ func init() {
 // If the string matches a 6-digit hexadecimal pattern, it extracts the digits
 // and returns the corresponding color value in the format "#RRGGBB". If the string
 // matches a 3-digit hexadecimal pattern, it duplicates each digit and returns
-// the color value in the format "#RRGGBB". If the string matches a color name in
-// the ColorNames map, it retrieves the hexadecimal value from the map and returns
-// it. If none of the above conditions are met, it returns the default color value
+// the color value in the format "#RRGGBB". If the string matches a color name or
+// alias of the color table, ignoring case, spaces, hyphens and apostrophes (so
+// "Blanched-Almond" and "blanchedalmond" are the same), it returns that color's
+// hexadecimal value. Where a loose spelling could be two colors, an exact
+// match wins, then the CSS color. If none of the above conditions are met, it returns the default color value
 // "#888888" and false to indicate that the conversion was unsuccessful.
 //
 // The function relies on the rxHex6 and rxHex3 regular expression patterns for
-// validating the hexadecimal strings. The function also converts the input string
-// to lowercase before processing. The function uses a strings.Builder to build
+// validating the hexadecimal strings. The function uses a strings.Builder to build
 // the resulting color value by appending characters.
 //
 // This function returns the hexadecimal color value as a string and a boolean flag
@@ -98,6 +255,8 @@ func init() {
 func StringToColor(s string) (hex string, ok bool) {
 	var sb strings.Builder
 
+	s = strings.TrimSpace(s)
+
 	if rxHex6.MatchString(s) {
 		zx := rxHex6.FindSubmatch([]byte(s))
 		sb.WriteRune('#')
@@ -117,11 +276,9 @@ func StringToColor(s string) (hex string, ok bool) {
 		return sb.String(), true
 	}
 
-	s = strings.ToLower(s)
-
-	hex, ok = ColorNames[s]
+	c, ok := lookupName(s)
 	if ok {
-		return hex, true
+		return c.Hex, true
 	}
 
 	return "#888888", false
@@ -134,34 +291,28 @@ func StringToColor(s string) (hex string, ok bool) {
 // https://www.w3.org/TR/WCAG20/#relativeluminancedef
 //
 // Parameters:
-// - `rgb`: An RGB color represented by three byte values in the range [0, 255].
+// - `r`, `g`, `b`: the red, green and blue values of the color, in the range [0, 255].
 //
 // Returns:
 //   - The relative luminance of the given RGB color as a floating-point value in the range [0, 1].
 //     A larger value indicates a brighter color.
 //
-// Panic:
-//   - If the `rgb` slice does not contain exactly three elements.
-//     This is to ensure correct usage and prevent calculation errors.
-//
 // Example usage:
 //
 //	rl := relativeLuminance(255, 255, 255)
 //	fmt.Println(rl) // Output: 1.0
-func relativeLuminance(rgb ...uint8) (rl float64) {
+func relativeLuminance(r, g, b uint8) (rl float64) {
 	var RGB [3]float64
 	// https://www.omnicalculator.com/other/contrast-ratio#how-do-i-calculate-the-color-contrast-ratio-between-two-colors
-	if len(rgb) != 3 {
-		msg := fmt.Sprintf("missized slice passed to sRGBrl (len != 3) len == %d", len(rgb))
-		panic(msg)
-	}
-	for ix := 0; ix < 3; ix++ {
+	for ix, c := range [3]uint8{r, g, b} {
 		var a float64
-		color := float64(rgb[ix]) / 255.0
+		color := float64(c) / 255.0
 		if color <= 0.04045 {
 			a = color / 12.92
 		} else {
-			t := (color + 0.55) / 1.055
+			// 0.055, as WCAG has it; this once read 0.55, which made
+			// every color darker than it is
+			t := (color + 0.055) / 1.055
 			a = math.Pow(t, 2.4)
 		}
 		RGB[ix] = a
@@ -170,18 +321,23 @@ func relativeLuminance(rgb ...uint8) (rl float64) {
 	return (0.2126 * RGB[0]) + (0.7152 * RGB[1]) + (0.0722 * RGB[2])
 }
 
-func InventColor(backColor string, minContrast int, minDistance int) (fg, bg string) {
+// InventColor returns a random foreground color that meets minContrast
+// and minDistance (percentages, as for RandomColor) against backColor,
+// and backColor as "#rrggbb". Without backColor, the background is
+// random too. An unknown backColor is an error wrapping ErrUnknownColor.
+// If none of 500 random colors meets the constraints, fg is black or
+// white, whichever contrasts more with the background, and the error
+// wraps ErrContrastUnsatisfiable.
+func InventColor(backColor string, minContrast int, minDistance int) (fg, bg string, err error) {
 	var contrast = float64(minContrast) / 100.0
 	var distance = float64(float64(3*0xFF)*float64(minDistance)) / 100.0
 	var cnt, dst float64
 	var ix int
-	var ok bool
 
 	if misc.IsStringSet(&backColor) {
-		bg, ok = StringToColor(backColor)
-		if !ok {
-			backColor = "white"
-			bg = "#FFFFFF"
+		bg, err = ParseColor(backColor)
+		if nil != err {
+			return "", "", err
 		}
 	} else {
 		bg = RandColor()
@@ -190,16 +346,31 @@ func InventColor(backColor string, minContrast int, minDistance int) (fg, bg str
 	cnt, dst = 0.0, 0.0
 	for ix < 500 && (cnt < contrast || dst < distance) {
 		fg = RandColor()
-		dst, cnt = ColorDistance(fg, bg)
+		dst, cnt, err = ColorDistance(fg, bg)
+		if nil != err {
+			return "", "", err
+		}
 		ix++
 	}
-	if ix >= 500 {
-		fg = "#FFFFFF" // white
-		bg = "#000000" // black
+	if cnt < contrast || dst < distance {
+		return blackOrWhite(bg), bg, unsatisfiable(minContrast, minDistance, bg, ix)
 	}
-	return fg, bg
+	return fg, bg, nil
 }
 
+// blackOrWhite returns "#000000" or "#ffffff", whichever has more
+// contrast against bg, a "#RRGGBB" color.
+func blackOrWhite(bg string) string {
+	// bg has been parsed by the callers
+	onBlack, _ := ContrastRatio("#000000", bg)
+	onWhite, _ := ContrastRatio("#ffffff", bg)
+	if onBlack >= onWhite {
+		return "#000000"
+	}
+	return "#ffffff"
+}
+
+// RandColor returns a random "#rrggbb" color.
 func RandColor() (color string) {
 	_, r, g, b := randColorBytes()
 	return fmt.Sprintf("#%02x%02x%02x", r, g, b)
@@ -207,40 +378,47 @@ func RandColor() (color string) {
 
 func randColorBytes() (sum, r, g, b int) {
 	bits := make([]byte, 3)
-	_, err := rand.Read(bits)
-	if err != nil {
-		msg := fmt.Sprintf("huh? could not generate random color because %s", err.Error())
-		_, _ = fmt.Fprintln(os.Stderr, msg)
-		panic(msg)
-	}
+	// neither crypto/rand nor seededReader return an error; crypto/rand
+	// crashes the program if the system's random source fails
+	_, _ = io.ReadFull(buffRandReader, bits)
 	return int(bits[0] + bits[1] + bits[2]), int(bits[0]), int(bits[1]), int(bits[2])
 }
 
-func hexByteToInt(hex string) (val int) {
-
-	// paranoia check. can remove in 2025.
+// hexByteToInt converts two hex digits to their value.
+func hexByteToInt(hex string) (val int, err error) {
 	if !rxHexB.MatchString(hex) {
-		panic("hex byte conversion failed for " + hex)
+		return 0, fmt.Errorf("%w: %q is not a hex byte", ErrInvalidHex, hex)
 	}
 
 	i, err := strconv.ParseInt(hex, 16, 32)
 	if err != nil {
-		msg := fmt.Sprintf("huh? could not convert %s into an int because %s", hex, err.Error())
-		_, _ = fmt.Fprintln(os.Stderr, msg)
-		panic(msg)
+		return 0, fmt.Errorf("%w: %w", ErrInvalidHex, err)
 	}
-	return int(i)
+	return int(i), nil
 }
 
 // getRGB converts a hexadecimal color representation to its RGB values.
-// It expects the color to be in the format "#RRGGBB".
-// If the color format is invalid, it will panic with an error message.
+// It expects the color to be in the format "#RRGGBB"; for any other
+// format, the error wraps ErrInvalidHex.
 // The function returns three integers representing the red, green, and blue values of the color.
-func getRGB(hex string) (r, g, b int) {
-	if !rxHex6.MatchString(hex) {
-		panic("invalid hex format: [" + hex + "] (don't do that!)")
+func getRGB(hex string) (r, g, b int, err error) {
+	if !rxHex6.MatchString(hex) || !strings.HasPrefix(hex, "#") {
+		return 0, 0, 0, fmt.Errorf("%w: %q", ErrInvalidHex, hex)
 	}
-	return hexByteToInt(hex[1:3]), hexByteToInt(hex[3:5]), hexByteToInt(hex[5:7])
+	r, err = hexByteToInt(hex[1:3])
+	if nil == err {
+		g, err = hexByteToInt(hex[3:5])
+	}
+	if nil == err {
+		b, err = hexByteToInt(hex[5:7])
+	}
+	return r, g, b, err
+}
+
+// RGB returns the red, green and blue values of a "#RRGGBB" color.
+// For any other format, the error wraps ErrInvalidHex.
+func RGB(hex string) (r, g, b int, err error) {
+	return getRGB(hex)
 }
 
 // ColorDistance calculates the Euclidean distance between two colors represented as hexadecimal strings,
@@ -248,12 +426,19 @@ func getRGB(hex string) (r, g, b int) {
 // The distance is calculated using the RGB values of the colors.
 // The relative luminance of the colors is calculated using the sRGB color space formula.
 // The function returns the distance and contrast ratio as floating-point values.
-// The colors are expected to be in the format "#RRGGBB".
+// The colors are expected to be in the format "#RRGGBB"; otherwise the
+// error wraps ErrInvalidHex.
 // Note that the maximum distance is 255 + 255 + 255 == 765
 
-func ColorDistance(a string, b string) (dist float64, contrast float64) {
-	aRed, aGreen, aBlue := getRGB(a)
-	bRed, bGreen, bBlue := getRGB(b)
+func ColorDistance(a string, b string) (dist float64, contrast float64, err error) {
+	aRed, aGreen, aBlue, err := getRGB(a)
+	if nil != err {
+		return 0, 0, err
+	}
+	bRed, bGreen, bBlue, err := getRGB(b)
+	if nil != err {
+		return 0, 0, err
+	}
 
 	r1 := relativeLuminance(uint8(aRed), uint8(aGreen), uint8(aBlue))
 	r2 := relativeLuminance(uint8(bRed), uint8(bGreen), uint8(bBlue))
@@ -268,56 +453,153 @@ func ColorDistance(a string, b string) (dist float64, contrast float64) {
 			math.Pow(float64(aGreen-bGreen), 2.0) +
 			math.Pow(float64(aBlue-bBlue), 2.0))
 
-	return dist, contrast
+	return dist, contrast, nil
 }
 
-func RandomColor(bg string, contrast int, distance int) (name string, hex string) {
-	var ok bool
-
+// RandomColor returns a random named color of the palette that meets
+// contrast and distance (percentages) against bg, or, if none does, an
+// invented one (see InventColor, whose errors it returns). name is ""
+// for an invented color. An unknown bg is an error wrapping
+// ErrUnknownColor; no bg means white.
+func RandomColor(bg string, contrast int, distance int) (name string, hex string, err error) {
 	minContrast := float64(contrast) / 100
 	minDistance := float64(3*0xFF) * (float64(distance) / 100)
 
 	if misc.IsStringSet(&bg) {
-		bg, ok = StringToColor(bg)
-		if !ok {
-			bg = "#FFFFFF" // white
+		bg, err = ParseColor(bg)
+		if nil != err {
+			return "", "", err
 		}
+	} else {
+		bg = "#FFFFFF" // white
 	}
 
 	ixBig, err := rand.Int(buffRandReader, htmlColorArrayLength)
 	if err != nil {
-		panic("Huh? rand.Int read failed because: " + err.Error())
+		return "", "", fmt.Errorf("%w: %w", ErrRandom, err)
 	}
 	ixStart := int(ixBig.Int64()) % len(htmlColorArray)
 	ix := ixStart
 
 	fg := htmlColorArray[ixStart].hex
-	dst, cst := ColorDistance(fg, bg)
+	dst, cst, err := ColorDistance(fg, bg)
+	if nil != err {
+		return "", "", err
+	}
 
-	for cst < minContrast && dst < minDistance {
+	for cst < minContrast || dst < minDistance {
 		ix++
 		if ix >= len(htmlColorArray) {
 			ix = 0
 		}
 		if ixStart == ix {
-			fg, _ = InventColor(bg, contrast, distance)
-			return "", fg
+			fg, _, err = InventColor(bg, contrast, distance)
+			return "", fg, err
 		}
 		fg = htmlColorArray[ix].hex
-		dst, cst = ColorDistance(fg, bg)
+		dst, cst, err = ColorDistance(fg, bg)
+		if nil != err {
+			return "", "", err
+		}
+	}
+	return htmlColorArray[ix].name, htmlColorArray[ix].hex, nil
+}
+
+// Satisfies reports whether fg meets both the minimum contrast and the
+// minimum distance against bg, as percentages like those RandomColor
+// takes. Both colors are "#RRGGBB"; if either is not, fg does not
+// satisfy.
+func Satisfies(fg string, bg string, minContrast int, minDistance int) bool {
+	dst, cst, err := ColorDistance(fg, bg)
+	return nil == err && cst >= float64(minContrast)/100.0 &&
+		dst >= float64(3*0xFF)*(float64(minDistance)/100.0)
+}
+
+// Achievable returns the most contrast and the most distance any color
+// can have against bg, as percentages like those RandomColor takes, so
+// that a request above either can be known to fail before searching.
+// The most contrast is that of black or white, and the most distance
+// that of the opposite corner of the RGB cube; no one color need have
+// both. An unknown bg is an error wrapping ErrUnknownColor.
+func Achievable(bg string) (contrast float64, distance float64, err error) {
+	hex, err := ParseColor(bg)
+	if nil != err {
+		return 0, 0, err
+	}
+	r, g, b, err := getRGB(hex)
+	if nil != err {
+		return 0, 0, err
+	}
+
+	lum := relativeLuminance(uint8(r), uint8(g), uint8(b))
+	contrast = math.Max(1.0-0.05/(lum+0.05), 1.0-(lum+0.05)/1.05)
+
+	far := func(c int) float64 { return float64(max(c, 0xFF-c)) }
+	distance = math.Sqrt(far(r)*far(r) + far(g)*far(g) + far(b)*far(b))
+
+	return 100 * contrast, 100 * distance / float64(3*0xFF), nil
+}
+
+// CheckSatisfiable returns an error wrapping ErrContrastUnsatisfiable,
+// with the most that is achievable, when no color can have minContrast
+// or no color can have minDistance against bg (see Achievable). A nil
+// error does not promise that one color has both; the searches of
+// RandomColor, InventColor and HashColor can still fail.
+func CheckSatisfiable(bg string, minContrast int, minDistance int) error {
+	contrast, distance, err := Achievable(bg)
+	if nil != err {
+		return err
+	}
+	if float64(minContrast) > contrast || float64(minDistance) > distance {
+		return fmt.Errorf("%w: %d%% contrast and %d%% distance against %s "+
+			"(at most %.1f%% contrast and %.1f%% distance are achievable)",
+			ErrContrastUnsatisfiable, minContrast, minDistance, bg, contrast, distance)
+	}
+	return nil
+}
+
+// NamedColor is one color of the built-in palette.
+type NamedColor struct {
+	Name    string `json:"name"`
+	Display string `json:"display"`
+	Hex     string `json:"hex"`
+	Source  string `json:"source"`
+}
+
+// Duplicate is a name that Palette leaves out, because Name, which
+// comes first in name order, has the same hex value.
+type Duplicate struct {
+	Hex     string `json:"hex"`
+	Name    string `json:"name"`
+	Dropped string `json:"dropped"`
+}
+
+// Duplicates returns the names of the palette's sources that Palette
+// leaves out because an earlier name has the same hex value, in name
+// order. StringToColor still accepts them.
+func Duplicates() []Duplicate {
+	return append(make([]Duplicate, 0, len(paletteDuplicates)), paletteDuplicates...)
+}
+
+// Palette returns the named colors that RandomColor and HashColor choose
+// from, in name order (see SetPaletteSources). Names that duplicate another color's hex value
+// are not included (they are still accepted by StringToColor).
+func Palette() []NamedColor {
+	palette := make([]NamedColor, 0, len(htmlColorArray))
+	for _, c := range htmlColorArray {
+		palette = append(palette, NamedColor{Name: c.name, Display: c.display, Hex: c.hex, Source: c.source})
 	}
-	return htmlColorArray[ix].name, htmlColorArray[ix].hex
+	return palette
 }
 
-// using crypto/rand for good(?) random numbers ...
-func RandNamedColor() (ix int, name, hex string) {
+// RandNamedColor returns a random color of the palette, with its index
+// in the palette. It fails only if the random source does, with an
+// error wrapping ErrRandom.
+func RandNamedColor() (ix int, name, hex string, err error) {
 	ixBig, err := rand.Int(buffRandReader, big.NewInt(int64(len(htmlColorArray))))
 	if nil != err {
-		msg := fmt.Sprintf(
-			"huh? Failed to generate a big.Int from %d (len of ColorNames array) because %s",
-			len(ColorNames), err.Error())
-		panic(msg)
+		return 0, "", "", fmt.Errorf("%w: %w", ErrRandom, err)
 	}
 	ix = int(ixBig.Int64())
-	return ix, htmlColorArray[ix].name, htmlColorArray[ix].hex
+	return ix, htmlColorArray[ix].name, htmlColorArray[ix].hex, nil
 }
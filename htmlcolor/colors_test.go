@@ -0,0 +1,27 @@
+package htmlcolors
+
+import (
+	"math"
+	"testing"
+)
+
+// TestRelativeLuminance checks relativeLuminance against the WCAG
+// definition, including a value on the curved part of it, which the
+// 0.55 typo got wrong.
+func TestRelativeLuminance(t *testing.T) {
+	cases := []struct {
+		r, g, b uint8
+		want    float64
+	}{
+		{0, 0, 0, 0},
+		{255, 255, 255, 1},
+		{0x77, 0x77, 0x77, 0.184475},
+		{255, 0, 0, 0.2126},
+		{10, 10, 10, 0.003035},
+	}
+	for _, c := range cases {
+		if got := relativeLuminance(c.r, c.g, c.b); 1e-5 < math.Abs(got-c.want) {
+			t.Errorf("relativeLuminance(%d, %d, %d) = %f, want %f", c.r, c.g, c.b, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package htmlcolors
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// HSL returns the hue (0 to 360 degrees), saturation and lightness
+// (0 to 1) of a "#RRGGBB" color. Any other format is an error wrapping
+// ErrInvalidHex.
+func HSL(hex string) (h, s, l float64, err error) {
+	r, g, b, err := getRGB(hex)
+	if nil != err {
+		return 0, 0, 0, err
+	}
+	rf, gf, bf := float64(r)/255.0, float64(g)/255.0, float64(b)/255.0
+	hi := math.Max(rf, math.Max(gf, bf))
+	lo := math.Min(rf, math.Min(gf, bf))
+	l = (hi + lo) / 2.0
+	if hi == lo {
+		return 0, 0, l, nil // gray: no hue
+	}
+	d := hi - lo
+	if l > 0.5 {
+		s = d / (2.0 - hi - lo)
+	} else {
+		s = d / (hi + lo)
+	}
+	switch hi {
+	case rf:
+		h = math.Mod((gf-bf)/d+6.0, 6.0)
+	case gf:
+		h = (bf-rf)/d + 2.0
+	default:
+		h = (rf-gf)/d + 4.0
+	}
+	return h * 60.0, s, l, nil
+}
+
+// HSLToHex returns the "#rrggbb" color for a hue (degrees), saturation
+// and lightness (0 to 1), the inverse of HSL.
+func HSLToHex(h, s, l float64) string {
+	h = math.Mod(math.Mod(h, 360.0)+360.0, 360.0)
+	c := (1.0 - math.Abs(2.0*l-1.0)) * s
+	x := c * (1.0 - math.Abs(math.Mod(h/60.0, 2.0)-1.0))
+	m := l - c/2.0
+	var r, g, b float64
+	switch {
+	case h < 60:
+		r, g, b = c, x, 0
+	case h < 120:
+		r, g, b = x, c, 0
+	case h < 180:
+		r, g, b = 0, c, x
+	case h < 240:
+		r, g, b = 0, x, c
+	case h < 300:
+		r, g, b = x, 0, c
+	default:
+		r, g, b = c, 0, x
+	}
+	toByte := func(v float64) int {
+		return int(math.Round(math.Max(0, math.Min(1, v+m)) * 255.0))
+	}
+	return fmt.Sprintf("#%02x%02x%02x", toByte(r), toByte(g), toByte(b))
+}
+
+// NamesFor returns every name in ColorNames for the "#RRGGBB" color hex,
+// in name order, or nil if the color has no name.
+func NamesFor(hex string) (names []string) {
+	target, ok := StringToColor(hex)
+	if !ok {
+		return nil
+	}
+	for name, val := range ColorNames {
+		if strings.EqualFold(val, target) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
@@ -1,1507 +1,1413 @@
 package htmlcolors
 
-// ColorNames maps names to hex value. *A duplicate name
-// will raise a compile-time error.* Might be duplicate
-// colors ... (same color, different names). All colornames
-// are lowercase for comparisons
-// Duplicate color values exist in this table, but are skinned out
-// in htmlColor.init().
-// hex values mapped to multiple names should be RETAINED in this
-// list for use of StringToColor. Similar colornames may be differentiated
-// by adding a digit iff they map to different hex values.
-// Same name-value combinations should be removed. Prefer names
-// WITHOUT a space.
-
-var ColorNames = map[string]string{
-	"acid green":               "#b0bf1a",
-	"aero blue":                "#c9ffe5",
-	"african violet":           "#b284be",
-	"air force blue (usaf)":    "#00308f",
-	"air superiority blue":     "#72a0c1",
-	"alabama crimson":          "#a32638",
-	"alabama crimson1":         "#af002a",
-	"algae green":              "#64e986",
-	"alice blue":               "#f0f8ff",
-	"aliceblue":                "#f0f8ff",
-	"alien green":              "#6cc417",
-	"alloy orange":             "#c46210",
-	"almond buff":              "#d1b894",
-	"almond":                   "#efdecd",
-	"almost mauve":             "#eadedb",
-	"amaranth pink":            "#f19cbb",
-	"amaranth purple":          "#ab274f",
-	"amaranth":                 "#e52b50",
-	"amazon":                   "#3b7a57",
-	"amber (sae/ece)":          "#ff7e00",
-	"american rose":            "#ff033e",
-	"amethyst orchid":          "#926aa6",
-	"amethyst":                 "#9966cc",
-	"amzn_0001":                "#630f32", /* red matte */
-	"amzn_0002":                "#0F6340", /* greenish matte */
-	"android green":            "#a4c639",
-	"anti flash white":         "#f2f3f4",
-	"antique brass":            "#cd9575",
-	"antique bronze":           "#665d1e",
-	"antique fuchsia":          "#915c83",
-	"antique ruby":             "#841b2d",
-	"antique white":            "#faebd7",
-	"antiquewhite":             "#faebd7",
-	"apple green":              "#8db600",
-	"apricot":                  "#fbceb1",
-	"aqua sky":                 "#7ac5c5",
-	"aqua":                     "#00ffff",
-	"aquamarine":               "#7fffd4",
-	"arcadia":                  "#00a591",
-	"arctic lime":              "#d0ff14",
-	"army brown":               "#827b60",
-	"army green":               "#4b5320",
-	"arsenic":                  "#3b444b",
-	"artichoke":                "#8f9779",
-	"ash gray":                 "#666362",
-	"ash grey":                 "#b2beb5",
-	"asparagus":                "#87a96b",
-	"aspen gold":               "#ffd662",
-	"atomic tangerine":         "#ff9966",
-	"aureolin":                 "#fdee00",
-	"aurometalsaurus":          "#6e7f80",
-	"autumn maple":             "#d2691e",
-	"avocado green":            "#b2c248",
-	"avocado":                  "#568203",
-	"aztech purple":            "#893bff",
-	"azure mist":               "#f0ffff",
-	"azure1":                   "#007fff",
-	"azure":                    "#f0ffff",
-	"baby blue eyes":           "#a1caf1",
-	"baby blue1":               "#89cff0",
-	"baby blue":                "#95b9c7",
-	"baby powder":              "#fefefa",
-	"ball blue":                "#21abcd",
-	"ballet slipper":           "#f3d6e4",
-	"banana mania":             "#fae7b5",
-	"banana yellow":            "#ffe135",
-	"barbie pink":              "#e0218a",
-	"barn red":                 "#7c0a02",
-	"bashful pink":             "#c25283",
-	"basket ball orange":       "#f88158",
-	"battleship gray":          "#848482",
-	"bazaar":                   "#98777b",
-	"bean red":                 "#f75d59",
-	"beaver":                   "#9f8170",
-	"bee yellow":               "#e9ab17",
-	"beer":                     "#fbb117",
-	"beetle green":             "#4c787e",
-	"beige":                    "#f5f5dc",
-	"big dip o’ruby":           "#9c2542",
-	"biking red":               "#77212e",
-	"bisque":                   "#ffe4c4",
-	"bistre":                   "#3d2b1f",
-	"bitter lemon":             "#cae00d",
-	"bitter lime":              "#648c11",
-	"bitter lime1":             "#bfff00",
-	"bittersweet shimmer":      "#bf4f51",
-	"bittersweet":              "#fe6f5e",
-	"black bean":               "#3d0c02",
-	"black cat":                "#413839",
-	"black cow":                "#4c4646",
-	"black eel":                "#463e3f",
-	"black leather jacket":     "#253529",
-	"black":                    "#000000",
-	"blanched almond":          "#ffebcd",
-	"blanchedalmond":           "#ffebcd",
-	"blast off bronze":         "#a57164",
-	"bleu de france":           "#318ce7",
-	"blizzard blue":            "#ace5ee",
-	"blond":                    "#faf0be",
-	"blonde":                   "#fbf6d9",
-	"blood red":                "#7e3517",
-	"blooming dahlia":          "#ec9787",
-	"blossom pink":             "#f9b7ff",
-	"blue (crayola)":           "#1f75fe",
-	"blue (munsell)":           "#0093af",
-	"blue (ncs)":               "#0087bd",
-	"blue (pigment)":           "#333399",
-	"blue (ryb)":               "#0247fe",
-	"blue angel":               "#b7ceec",
-	"blue bell":                "#a2a2d0",
-	"blue diamond":             "#4ee2ec",
-	"blue dress":               "#157dec",
-	"blue eyes":                "#1569c7",
-	"blue gray":                "#98afc7",
-	"blue green":               "#0d98ba",
-	"blue green1":              "#7bccb5",
-	"blue hosta":               "#77bfc7",
-	"blue iris":                "#595ca1",
-	"blue ivy":                 "#3090c7",
-	"blue jay":                 "#2b547e",
-	"blue koi":                 "#659ec7",
-	"blue lagoon":              "#8eebec",
-	"blue lotus":               "#6960ec",
-	"blue orchid":              "#1f45fc",
-	"blue ribbon":              "#306eff",
-	"blue sapphire":            "#126180",
-	"blue turquoise":           "#4fb0ae",
-	"blue violet":              "#8a2be2",
-	"blue whale":               "#342d7e",
-	"blue yonder":              "#5072a7",
-	"blue zircon":              "#57feff",
-	"blue":                     "#0000ff",
-	"blueberry blue":           "#0041c2",
-	"blueberry":                "#4f86f7",
-	"bluebonnet":               "#1c1cf0",
-	"bluestone":                "#577284",
-	"blueviolet":               "#8a2be2",
-	"blush pink":               "#e6a9ec",
-	"blush red":                "#e56e94",
-	"blush":                    "#de5d83",
-	"bondi blue":               "#0095b6",
-	"bone":                     "#e3dac9",
-	"bored accent green":       "#dde26a",
-	"boston university red":    "#cc0000",
-	"bottle green":             "#006a4e",
-	"boysenberry":              "#873260",
-	"brandeis blue":            "#0070ff",
-	"brass":                    "#b5a642",
-	"brick red":                "#cb4154",
-	"bright cerulean":          "#1dacd6",
-	"bright gold":              "#fdd017",
-	"bright green":             "#66ff00",
-	"bright lavender":          "#bf94e4",
-	"bright lilac":             "#d891ef",
-	"bright maroon":            "#c32148",
-	"bright navy blue":         "#1974d2",
-	"bright neon pink":         "#f433ff",
-	"bright turquoise":         "#08e8de",
-	"bright ube":               "#d19fe8",
-	"brilliant rose":           "#ff55a3",
-	"brink pink":               "#fb607f",
-	"british racing green":     "#004225",
-	"bronze yellow":            "#737000",
-	"bronze":                   "#cd7f32",
-	"brown (traditional)":      "#964b00",
-	"brown bear":               "#835c3b",
-	"brown granite":            "#615550",
-	"brown sugar":              "#e2a76f",
-	"brown":                    "#a52a2a",
-	"bubble gum":               "#ffc1cc",
-	"bubbles":                  "#e7feff",
-	"bud green":                "#7bb661",
-	"buff":                     "#f0dc82",
-	"bulgarian rose":           "#480607",
-	"bullet shell":             "#af9b60",
-	"burgundy":                 "#800020",
-	"burgundy1":                "#8c001a",
-	"burly wood":               "#deb887",
-	"burlywood":                "#deb887",
-	"burnt coral":              "#e9897e",
-	"burnt orange":             "#cc5500",
-	"burnt pink":               "#c12267",
-	"burnt umber":              "#8a3324",
-	"butter rum":               "#c48f65",
-	"buttercream":              "#efe1ce",
-	"buttercup":                "#fae03c",
-	"butterfly blue":           "#38acec",
-	"byzantine":                "#bd33a4",
-	"byzantium":                "#702963",
-	"cadet blue":               "#5f9ea0",
-	"cadet grey":               "#91a3b0",
-	"cadet":                    "#536872",
-	"cadetblue":                "#5f9ea0",
-	"cadillac pink":            "#e38aae",
-	"cadmium green":            "#006b3c",
-	"cadmium orange":           "#ed872d",
-	"cadmium red":              "#e30022",
-	"cadmium yellow":           "#fff600",
-	"café noir":                "#4b3621",
-	"cal poly green":           "#1e4d2b",
-	"calamansi":                "#fcffa4",
-	"cambridge blue":           "#a3c1ad",
-	"camel brown":              "#c19a6b",
-	"cameo pink":               "#efbbcc",
-	"camouflage green":         "#78866b",
-	"canary yellow":            "#ffef00",
-	"candy apple red":          "#ff0800",
-	"cantaloupe":               "#ffa62f",
-	"caput mortuum":            "#592720",
-	"caramel":                  "#c68e17",
-	"carbon gray":              "#625d5d",
-	"cardinal":                 "#c41e3a",
-	"caribbean green":          "#00cc99",
-	"carmine pink":             "#eb4c42",
-	"carmine red":              "#ff0038",
-	"carmine":                  "#960018",
-	"carnation pink":           "#ffa6c9",
-	"carnation pink1":          "#f778a1",
-	"carolina blue":            "#99badd",
-	"carrot orange":            "#ed9121",
-	"catalina blue":            "#062a78",
-	"catawba":                  "#703642",
-	"cedar chest":              "#c95a49",
-	"ceil":                     "#92a1cf",
-	"celadon green":            "#2f847c",
-	"celadon":                  "#ace1af",
-	"celeste":                  "#50ebec",
-	"celestial blue":           "#4997d0",
-	"cerise pink":              "#ec3b83",
-	"cerulean blue":            "#2a52be",
-	"cerulean frost":           "#6d9bc3",
-	"cerulean":                 "#007ba7",
-	"cerulean1":                "#9bb7d6",
-	"ceylon yellow":            "#d5ae41",
-	"cg blue":                  "#007aa5",
-	"cg red":                   "#e03c31",
-	"chamoisee":                "#a0785a",
-	"champagne":                "#f7e7ce",
-	"charcoal":                 "#36454f",
-	"charcoal1":                "#34282c",
-	"charleston green":         "#232b2b",
-	"chartreuse":               "#7fff00",
-	"chartreuse1":              "#dfff00",
-	"chartreuse2":              "#8afb17",
-	"cherry blossom pink":      "#ffb7c5",
-	"cherry red":               "#c24641",
-	"cherry tomato":            "#e94b3c",
-	"cherry":                   "#de3163",
-	"chestnut red":             "#c34a2c",
-	"chestnut":                 "#954535",
-	"chili oil":                "#944743",
-	"chili pepper":             "#9c1b31",
-	"chilli pepper":            "#c11b17",
-	"china rose":               "#a8516e",
-	"chinese green":            "#d0db61",
-	"chinese red":              "#aa381e",
-	"chinese violet":           "#856088",
-	"chocolate (traditional)":  "#7b3f00",
-	"chocolate":                "#d2691e",
-	"chocolate1":               "#c85a17",
-	"chrome yellow":            "#ffa700",
-	"cinereous":                "#98817b",
-	"cinnamon":                 "#c58917",
-	"citrine":                  "#e4d00a",
-	"citron":                   "#9fa91f",
-	"claret":                   "#7f1734",
-	"classic blue":             "#0f4c81",
-	"classic rose":             "#fbcce7",
-	"cloudy gray":              "#6d6968",
-	"clover green":             "#3ea055",
-	"coal":                     "#7cb9e8",
-	"cobalt blue":              "#0020c2",
-	"cobalt":                   "#0047ab",
-	"cocoa brown":              "#d2691e",
-	"coconut milk":             "#f0ede5",
-	"coconut":                  "#965a3e",
-	"coffee":                   "#6f4e37",
-	"columbia blue":            "#9bddff",
-	"columbia blue1":           "#87afc7",
-	"construction cone orange": "#f87431",
-	"cookie brown":             "#c7a317",
-	"cool black":               "#002e63",
-	"copper penny":             "#ad6f69",
-	"copper red":               "#cb6d51",
-	"copper rose":              "#996666",
-	"copper":                   "#b87333",
-
-	"coquelicot": "#ff3800",
-	"coral blue": "#afdcec",
-	"coral pink": "#f88379",
-	"coral red":  "#ff4040",
-
-	"coral":                 "#ff7f50",
-	"cordovan":              "#893f45",
-	"corn flower blue":      "#6495ed",
-	"corn silk":             "#fff8dc",
-	"corn yellow":           "#fff380",
-	"cornell red":           "#b31b1b",
-	"cornflower blue":       "#6495ed",
-	"cornflowerblue":        "#6495ed",
-	"cornsilk":              "#fff8dc",
-	"cosmic latte":          "#fff8e7",
-	"cotton candy":          "#ffbcd9",
-	"cotton candy1":         "#fcdfff",
-	"cranberry":             "#9f000f",
-	"cream":                 "#fffdd0",
-	"crimson glory":         "#be0032",
-	"crimson":               "#dc143c",
-	"crimson1":              "#e238ec",
-	"crocus petal":          "#be9ec9",
-	"crocus purple":         "#9172ec",
-	"crystal blue":          "#5cb3ff",
-	"crème de pêche":        "#f5d6c6",
-	"cyan (process)":        "#00b7eb",
-	"cyan opaque":           "#92c7c7",
-	"cyan":                  "#00ffff", // duplicate name for aqua
-	"cyber grape":           "#58427c",
-	"cyber yellow":          "#ffd300",
-	"daffodil":              "#ffff31",
-	"dandelion":             "#f0e130",
-	"dark blue gray":        "#666699",
-	"dark blue":             "#00008b",
-	"dark byzantium":        "#5d3954",
-	"dark candy apple red":  "#a40000",
-	"dark carnation pink":   "#c12283",
-	"dark cerulean":         "#08457e",
-	"dark cheddar":          "#e08119",
-	"dark chestnut":         "#986960",
-	"dark coral":            "#cd5b45",
-	"dark cyan":             "#008b8b",
-	"dark forest green":     "#254117",
-	"dark goldenrod":        "#af7817",
-	"dark gray":             "#a9a9a9",
-	"dark grey":             "#a9a9a9",
-	"dark imperial blue":    "#00416a",
-	"dark khaki":            "#bdb76b",
-	"dark lavender":         "#734f96",
-	"dark lemon lime":       "#8bbe1b",
-	"dark liver":            "#534b4f",
-	"dark magenta":          "#8b008b",
-	"dark midnight blue":    "#000036",
-	"dark midnight blue1":   "#003366",
-	"dark moss green":       "#4a5d23",
-	"dark olive green":      "#556b2f",
-	"dark orange":           "#ff8c00",
-	"dark orange1":          "#f88017",
-	"dark orchid":           "#9932cc",
-	"dark orchid1":          "#7d1b7e",
-	"dark pastel blue":      "#779ecb",
-	"dark pastel green":     "#03c03c",
-	"dark pastel purple":    "#966fd6",
-	"dark pastel red":       "#c23b22",
-	"dark pink":             "#e75480",
-	"dark powder blue":      "#000039",
-	"dark puce":             "#4f3a3c",
-	"dark raspberry":        "#872657",
-	"dark red":              "#8b0000",
-	"dark salmon":           "#e9967a",
-	"dark scarlet":          "#560319",
-	"dark sea green":        "#8fbc8f",
-	"dark sea green1":       "#8bb381",
-	"dark sienna":           "#3c1414",
-	"dark sky blue":         "#8cbed6",
-	"dark slate blue":       "#483d8b",
-	"dark slate blue1":      "#2b3856",
-	"dark slate gray":       "#2f4f4f",
-	"dark slate grey":       "#25383c",
-	"dark spring green":     "#177245",
-	"dark tan":              "#918151",
-	"dark tangerine":        "#ffa812",
-	"dark terra cotta":      "#cc4e5c",
-	"dark turquoise":        "#00ced1",
-	"dark turquoise1":       "#3b9c9c",
-	"dark vanilla":          "#d1bea8",
-	"dark violet":           "#9400d3",
-	"dark violet1":          "#842dce",
-	"dark yellow":           "#9b870c",
-	"darkblue":              "#00008b",
-	"darkcyan":              "#008b8b",
-	"darkgoldenrod":         "#b8860b",
-	"darkgray":              "#a9a9a9",
-	"darkgreen":             "#006400",
-	"darkgrey":              "#a9a9a9",
-	"darkkhaki":             "#bdb76b",
-	"darkmagenta":           "#8b008b",
-	"darkolivegreen":        "#556b2f",
-	"darkorange":            "#ff8c00",
-	"darkred":               "#8b0000",
-	"darksalmon":            "#e9967a",
-	"darkseagreen":          "#8fbc8f",
-	"darkslateblue":         "#483d8b",
-	"darkslategray":         "#2f4f4f",
-	"darkslategrey":         "#2f4f4f",
-	"darkturquoise":         "#00ced1",
-	"darkviolet":            "#9400d3",
-	"dartmouth green":       "#00703c",
-	"davy’s grey":           "#555555",
-	"day sky blue":          "#82caff",
-	"debian red":            "#d70a53",
-	"deep carmine pink":     "#ef3038",
-	"deep carmine":          "#a9203e",
-	"deep carrot orange":    "#e9692c",
-	"deep cerise":           "#da3287",
-	"deep chestnut":         "#b94e48",
-	"deep fuchsia":          "#c154c1",
-	"deep jungle green":     "#004b49",
-	"deep lemon":            "#f5c71a",
-	"deep lilac":            "#9955bb",
-	"deep magenta":          "#cc00cc",
-	"deep peach":            "#ffcba4",
-	"deep pink":             "#ff1493",
-	"deep pink1":            "#f52887",
-	"deep puce":             "#a95c68",
-	"deep ruby":             "#843f5b",
-	"deep saffron":          "#ff9933",
-	"deep sky blue":         "#00bfff",
-	"deep sky blue1":        "#3bb9ff",
-	"deep space sparkle":    "#4a646c",
-	"deep taupe":            "#7e5e60",
-	"deep tuscan red":       "#66424d",
-	"deeppink":              "#ff1493",
-	"deepskyblue":           "#00bfff",
-	"deer":                  "#ba8759",
-	"denim blue":            "#79baec",
-	"denim dark blue":       "#151b8d",
-	"denim":                 "#1560bd",
-	"desert mist":           "#e0b589",
-	"desert sand":           "#edc9af",
-	"desire":                "#ea3c53",
-	"diamond":               "#b9f2ff",
-	"dim gray":              "#696969",
-	"dim grey":              "#696969",
-	"dimgray":               "#696969",
-	"dimgrey":               "#696969",
-	"dimorphotheca magenta": "#e3319d",
-	"dirt":                  "#9b7653",
-	"dodger blue":           "#1e90ff",
-	"dodger blue1":          "#1589ff",
-	"dodgerblue":            "#1e90ff",
-	"dogwood rose":          "#d71868",
-	"dollar bill green":     "#85bb65",
-	"dollar bill":           "#85bb65",
-	"donkey brown":          "#664c28",
-	"dragon green":          "#6afb92",
-	"duke blue":             "#00009c",
-	"dull purple":           "#7f525d",
-	"dust storm":            "#e5ccc9",
-	"dutch white":           "#efdfbb",
-	"earth blue":            "#0000a0",
-	"earth yellow":          "#e1a95f",
-	"ebony":                 "#555d50",
-	"eclipse":               "#343148",
-	"eden":                  "#264e36",
-	"eggplant":              "#614051",
-
-	"eggshell":             "#f0ead6",
-	"egyptian blue":        "#1034a6",
-	"electric blue":        "#7df9ff",
-	"electric blue1":       "#9afeff",
-	"electric crimson":     "#ff003f",
-	"electric cyan":        "#00ffff",
-	"electric indigo":      "#6f00ff",
-	"electric lavender":    "#f4bbff",
-	"electric lime":        "#ccff00",
-	"electric purple":      "#bf00ff",
-	"electric ultramarine": "#3f00ff",
-	"emerald green":        "#5ffb17",
-	"emerald":              "#169c78",
-	"eminence":             "#6c3082",
-	"emperador":            "#6c4f3d",
-	"english green":        "#1b4d3e",
-	"english lavender":     "#b48395",
-	"english red":          "#ab4b52",
-	"eton blue":            "#96c8a2",
-	"eucalyptus":           "#44d7a8",
-	"evening blue":         "#2a293e",
-	"fall leaf brown":      "#c8b560",
-	"falu red":             "#801818",
-	"fandango pink":        "#de5285",
-	"fandango":             "#b53389",
-	"fawn":                 "#e5aa70",
-	"feldgrau":             "#4d5d53",
-	"fern green":           "#667c26",
-	"ferrari red":          "#ff2800",
-	"field drab":           "#6c541e",
-	"fiesta":               "#dd4132",
-	"fire brick":           "#b22222",
-	"fire engine red":      "#ce2029",
-	"firebrick":            "#b22222",
-	"firebrick1":           "#800517",
-	"flame":                "#f2552c",
-	"flamingo pink":        "#fc8eac",
-	"flamingo pink1":       "#f9a7b0",
-	"flattery":             "#6b4423",
-	"flavescent":           "#f7e98e",
-	"flax":                 "#eedc82",
-	"flirt":                "#a2006d",
-	"floral white":         "#fffaf0",
-	"floralwhite":          "#fffaf0",
-	"fluorescent orange":   "#ffbf00",
-	"fluorescent pink":     "#ff1493",
-	"folly":                "#ff004f",
-	"forest green":         "#4e9258",
-	"forestgreen":          "#228b22",
-	"french bistre":        "#856d4d",
-	"french blue":          "#0072bb",
-	"french blue1":         "#0072b5",
-	"french fuchsia":       "#fd3f92",
-	"french lime":          "#9efd38",
-	"french mauve":         "#d473d4",
-	"french pink":          "#fd6c9e",
-	"french puce":          "#4e1609",
-	"french raspberry":     "#c72c48",
-	"french rose":          "#f64a8a",
-	"french sky blue":      "#77b5fe",
-	"french wine":          "#ac1e44",
-	"fresh air":            "#a6e7ff",
-	"frog green":           "#99c68e",
-	"fruit dove":           "#ce5b78",
-	"fuchsia pink":         "#ff77ff",
-	"fuchsia purple":       "#cc397b",
-	"fuchsia rose":         "#c74375",
-	"fuchsia":              "#ff00ff",
-	"fulvous":              "#e48400",
-	"fuschia rose":         "#c94476",
-	"fuzzy wuzzy":          "#cc6666",
-	"gainsboro":            "#dcdcdc",
-	"galaxy blue":          "#2a4b7c",
-	"gamboge":              "#e49b0f",
-	"generic viridian":     "#007f66",
-	"ghost white":          "#f8f8ff",
-	"ghostwhite":           "#f8f8ff",
-	"giants orange":        "#fe5a1d",
-	"gin":                  "#d8e4bc",
-	"ginger brown":         "#c9be62",
-	"ginger":               "#b06500",
-	"glacial blue ice":     "#368bc1",
-	"glaucous":             "#6082b6",
-	"glitter":              "#e6e8fa",
-	"go green":             "#00ab66",
-	"gold (metallic)":      "#d4af37",
-	"gold fusion":          "#85754e",
-	"gold":                 "#ffd700",
-	"golden brown":         "#eac117",
-	"golden lime":          "#9c9a40",
-	"golden poppy":         "#fcc200",
-	"golden yellow":        "#ffdf00",
-	"goldenrod":            "#daa520",
-	"goldenrod1":           "#edda74",
-	"granite":              "#837e7c",
-	"granny smith apple":   "#a8e4a0",
-	"grape":                "#6f2da8",
-	"grape1":               "#5e5a80",
-	"grapefruit":           "#dc381f",
-	"gray (x11 gray)":      "#bebebe",
-	"gray asparagus":       "#465945",
-	"gray blue":            "#8c92ac",
-	"gray cloud":           "#b6b6b4",
-	"gray dolphin":         "#5c5858",
-	"gray goose":           "#d1d0ce",
-	"gray wolf":            "#504a4b",
-	"gray":                 "#808080",
-	"gray2":                "#736f6e",
-	"grayish turquoise":    "#5e7d7e",
-	"green (crayola)":      "#1cac78",
-	"green (munsell)":      "#00a877",
-	"green (ncs)":          "#009f6b",
-	"green (pigment)":      "#00a550",
-	"green (ryb)":          "#66b032",
-	"green apple":          "#4cc417",
-	"green ash":            "#a0daa9",
-	"green flash":          "#79c753",
-	"green onion":          "#6aa121",
-	"green peas":           "#89c35c",
-	"green snake":          "#6cbb3c",
-	"green thumb":          "#b5eaaa",
-	"green yellow":         "#b1fb17",
-	"green":                "#008000",
-	"greenery":             "#88b04b",
-	"greenish blue":        "#307d7e",
-	"greenyellow":          "#adff2f",
-	"grenadine":            "#dc4c46",
-
-	"grey":              "#808080",
-	"grullo":            "#a99a86",
-	"guacamole":         "#797b3a",
-	"gunmetal":          "#2c3539",
-	"halloween orange":  "#e66c2c",
-	"han blue":          "#446ccf",
-	"han purple":        "#5218fa",
-	"hansa yellow":      "#e9d66b",
-	"harbor mist":       "#b4b7ba",
-	"harlequin":         "#3fff00",
-	"harvard crimson":   "#c90016",
-	"harvest gold":      "#ede275",
-	"hazel green":       "#617c58",
-	"hazelnut":          "#cfb095",
-	"heliotrope purple": "#d462ff",
-	"heliotrope":        "#df73ff",
-	"hollywood cerise":  "#f400a1",
-	"honey dew":         "#f0fff0",
-	"honeydew":          "#f0fff0",
-
-	"honeysuckle":                        "#da4f70",
-	"honolulu blue":                      "#006db0",
-	"honolulu blue1":                     "#007fbf",
-	"hooker green":                       "#49796b",
-	"hot magenta":                        "#ff1dce",
-	"hot pink":                           "#ff69b4",
-	"hot pink1":                          "#f660ab",
-	"hotpink":                            "#ff69b4",
-	"hummingbird green":                  "#7fe817",
-	"hunter green":                       "#355e3b",
-	"iceberg":                            "#71a6d2",
-	"iceberg1":                           "#56a5ec",
-	"iced coffee":                        "#b18f6a",
-	"icterine":                           "#fcf75e",
-	"iguana green":                       "#9cb071",
-	"illuminating emerald":               "#319177",
-	"illuminating":                       "#f5df4d",
-	"imperial blue":                      "#002395",
-	"imperial red":                       "#ed2939",
-	"imperial":                           "#602f6b",
-	"inchworm":                           "#b2ec5d",
-	"independence":                       "#4c516d",
-	"india green":                        "#138808",
-	"indian red":                         "#cd5c5c",
-	"indian yellow":                      "#e3a857",
-	"indianred":                          "#cd5c5c",
-	"indigo":                             "#4b0082",
-	"inkwell":                            "#363945",
-	"international klein blue":           "#002fa7",
-	"international orange (engineering)": "#ba160c",
-	"international orange (golden gate bridge)": "#c0362c",
-	"international orange":                      "#ff4f00",
-	"iridium":                                   "#3d3c3a",
-	"iris":                                      "#5a4fcf",
-	"isabelline":                                "#f4f0ec",
-	"islamic green":                             "#009900",
-	"island paradise":                           "#95dee3",
-	"italian sky blue":                          "#b2ffff",
-	"ivory":                                     "#fffff0",
-	"jade green":                                "#5efb6e",
-	"jade":                                      "#00a86b",
-	"japanese carmine":                          "#9d2933",
-	"japanese indigo":                           "#264348",
-	"japanese violet":                           "#5b3256",
-	"jasmine purple":                            "#a23bec",
-	"jasper":                                    "#d73b3e",
-	"jazzberry jam":                             "#a50b5e",
-	"jeans blue":                                "#a0cfec",
-	"jelly bean":                                "#da614e",
-	"jellyfish":                                 "#46c7c7",
-	"jester red":                                "#9e1030",
-	"jet gray":                                  "#616d7e",
-	"jet":                                       "#343434",
-	"jonquil":                                   "#f4ca16",
-	"jordy blue":                                "#8ab9f1",
-	"june bud":                                  "#bdda57",
-	"jungle green":                              "#29ab87",
-	"jungle green1":                             "#347c2c",
-	"kale":                                      "#5a7247",
-	"kelly green":                               "#4cc552",
-	"kenyan copper":                             "#7c1c05",
-	"keppel":                                    "#3ab09e",
-	"key lime":                                  "#e8f48c",
-	"khaki rose":                                "#c5908e",
-	"khaki":                                     "#c3b091",
-	"khaki1":                                    "#f0e68c",
-	"khaki2":                                    "#ada96e",
-	"kobi":                                      "#e79fc4",
-	"kombu green":                               "#354230",
-	"ku crimson":                                "#e8000d",
-	"la salle green":                            "#087830",
-	"languid lavender":                          "#d6cadd",
-	"lapis blue":                                "#15317e",
-	"lapis blue1":                               "#004b8d",
-	"lapis lazuli":                              "#26619c",
-	"laser lemon":                               "#fefe22",
-	"laurel green":                              "#a9ba9d",
-	"lava red":                                  "#e42217",
-	"lava":                                      "#cf1020",
-	"lavender (floral)":                         "#b57edc",
-	"lavender blue":                             "#e3e4fa",
-	"lavender blush":                            "#fff0f5",
-	"lavender gray":                             "#c4c3d0",
-	"lavender magenta":                          "#ee82ee",
-	"lavender mist":                             "#e6e6fa",
-	"lavender pink":                             "#fbaed2",
-	"lavender pinocchio":                        "#ebdde2",
-	"lavender purple":                           "#967bb6",
-	"lavender rose":                             "#fba0e3",
-	"lavender":                                  "#e6e6fa",
-	"lavenderblush":                             "#fff0f5",
-	"lawn green":                                "#87f717",
-	"lawngreen":                                 "#7cfc00",
-	"lemon chiffon":                             "#fffacd",
-	"lemon curry":                               "#cca01d",
-	"lemon glacier":                             "#fdff00",
-	"lemon lime":                                "#e3ff00",
-	"lemon meringue":                            "#f6eabe",
-	"lemon yellow":                              "#fff44f",
-	"lemon":                                     "#fff700",
-	"lemonchiffon":                              "#fffacd",
-	"liberty":                                   "#545aa7",
-	"light apricot":                             "#fdd5b1",
-	"light aquamarine":                          "#93ffe8",
-	"light blue":                                "#add8e6",
-	"light blue1":                               "#addfff",
-	"light brown":                               "#b5651d",
-	"light carmine pink":                        "#e66771",
-	"light coral":                               "#f08080",
-	"light cornflower blue":                     "#93ccea",
-	"light crimson":                             "#f56991",
-	"light cyan":                                "#e0ffff",
-	"light deep pink":                           "#ff5ccd",
-	"light fuchsia pink":                        "#f984ef",
-	"light goldenrod yellow":                    "#fafad2",
-	"light gray":                                "#d3d3d3",
-	"light green":                               "#90ee90",
-	"light grey":                                "#d3d3d3",
-	"light hot pink":                            "#ffb3de",
-	"light jade":                                "#c3fdb8",
-	"light medium orchid":                       "#d39bcb",
-	"light moss green":                          "#addfad",
-	"light orchid":                              "#e6a8d7",
-	"light pastel purple":                       "#b19cd9",
-	"light pink":                                "#ffb6c1",
-	"light pink1":                               "#faafba",
-	"light red ochre":                           "#e97451",
-	"light salmon pink":                         "#ff9999",
-	"light salmon":                              "#ffa07a",
-	"light sea green":                           "#20b2aa",
-	"light sea green1":                          "#3ea99f",
-	"light sky blue":                            "#87cefa",
-
-	"light slate blue":     "#736aff",
-	"light slate gray":     "#778899",
-	"light slate gray1":    "#6d7b8d",
-	"light slate grey":     "#778899",
-	"light slate":          "#ccffff",
-	"light steel blue1":    "#b0c4de",
-	"light steel blue":     "#728fce",
-	"light taupe":          "#b38b6d",
-	"light thulian pink":   "#e68fac",
-	"light yellow":         "#ffffe0",
-	"lightblue":            "#add8e6",
-	"lightcoral":           "#f08080",
-	"lightcyan":            "#e0ffff",
-	"lightgoldenrodyellow": "#fafad2",
-	"lightgray":            "#d3d3d3",
-	"lightgreen":           "#90ee90",
-	"lightgrey":            "#d3d3d3",
-	"lightpink":            "#ffb6c1",
-	"lightsalmon":          "#ffa07a",
-	"lightseagreen":        "#20b2aa",
-	"lightskyblue":         "#87cefa",
-	"lightslategray":       "#778899",
-	"lightslategrey":       "#778899",
-	"lightsteelblue":       "#b0c4de",
-	"lightyellow":          "#ffffe0",
-	"lilac grey":           "#9896a4",
-	"lilac":                "#c8a2c8",
-	"lime green":           "#41a317",
-	"lime pulp":            "#d1e189",
-	"lime punch":           "#bfd641",
-	"lime":                 "#00ff00",
-	"limegreen":            "#32cd32",
-	"limelight":            "#f1ea7f",
-	"limerick":             "#9dc209",
-	"limpet shell":         "#98ddde",
-	"lincoln green":        "#195905",
-	"linen":                "#faf0e6",
-
-	"lipstick pink":            "#c48793",
-	"little boy blue":          "#6ca0dc",
-	"little boy blue1":         "#6f9fd8",
-	"liver chestnut":           "#987456",
-	"livid":                    "#6699cc",
-	"living coral":             "#ff6f61",
-	"love red":                 "#e41b17",
-	"lovely purple":            "#7f38ec",
-	"lumber":                   "#ffe4cd",
-	"lust":                     "#e62020",
-	"macaroni and cheese":      "#f2bb66",
-	"macaw blue green":         "#43bfc7",
-	"magenta (dye)":            "#ca1f7b",
-	"magenta (process)":        "#ff0090",
-	"magenta haze":             "#9f4576",
-	"magenta":                  "#ff00ff", // alternate name: magenta
-	"magic mint":               "#aaf0d1",
-	"magnolia":                 "#f8f4ff",
-	"mahogany":                 "#c04000",
-	"maize":                    "#fbec5d",
-	"majorelle blue":           "#6050dc",
-	"malachite":                "#0bda51",
-	"manatee":                  "#979aaa",
-	"mango mojito":             "#d69c2f",
-	"mango orange":             "#ff8040",
-	"mango tango":              "#ff8243",
-	"mantis":                   "#74c365",
-	"marble blue":              "#566d7e",
-	"mardi gras":               "#880085",
-	"margarita":                "#b0c24a",
-	"marigold":                 "#fdac53",
-	"marina":                   "#4f84c4",
-	"maroon":                   "#800000",
-	"maroon1":                  "#810541",
-	"marsala":                  "#955251",
-	"martini olive":            "#766f57",
-	"mauve":                    "#e0b0ff",
-	"mauvelous":                "#ef98aa",
-	"maximum green yellow":     "#d9e650",
-	"maya blue":                "#73c2fb",
-	"meadowlark":               "#ecdb54",
-	"meat brown":               "#e5b73b",
-	"medium aquamarine":        "#66cdaa",
-	"medium aquamarine1":       "#348781",
-	"medium blue":              "#0000cd",
-	"medium candy apple red":   "#e2062c",
-	"medium electric blue":     "#035096",
-	"medium forest green":      "#347235",
-	"medium jungle green":      "#1c352d",
-	"medium orchid":            "#ba55d3",
-	"medium orchid1":           "#b048b5",
-	"medium purple":            "#9370db",
-	"medium purple1":           "#8467d7",
-	"medium red violet":        "#bb3385",
-	"medium ruby":              "#aa4069",
-	"medium sea green":         "#306754",
-	"medium sky blue":          "#80daeb",
-	"medium slate blue":        "#7b68ee",
-	"medium spring bud":        "#c9dc87",
-	"medium spring green":      "#348017",
-	"medium taupe":             "#674c47",
-	"medium turquoise":         "#48d1cc",
-	"medium turquoise1":        "#48cccd",
-	"medium tuscan red":        "#79443b",
-	"medium vermilion":         "#d9603b",
-	"medium violet red":        "#c71585",
-	"medium violet red1":       "#ca226b",
-	"mediumaquamarine":         "#66cdaa",
-	"mediumblue":               "#0000cd",
-	"mediumorchid":             "#ba55f3",
-	"mediumpurple":             "#9370db",
-	"mediumseagreen":           "#3cb371",
-	"mediumslateblue":          "#7b68ee",
-	"mediumspringgreen":        "#00fa9a",
-	"mediumturquoise":          "#48d1cc",
-	"mediumvioletred":          "#c71585",
-	"meerkat":                  "#a9754f",
-	"mellow apricot":           "#f8b878",
-	"mellow yellow":            "#f8de7e",
-	"melon":                    "#fdbcb4",
-	"metallic seaweed":         "#0a7e8c",
-	"metallic silver":          "#bcc6cc",
-	"metallic sunburst":        "#9c7c38",
-	"mexican pink":             "#e4007c",
-	"middle green yellow":      "#acbf60",
-	"midnight blue":            "#191970",
-	"midnight blue1":           "#151b54",
-	"midnight green":           "#004953",
-	"midnight":                 "#2b1b17",
-	"midnightblue":             "#191970",
-	"mikado yellow":            "#ffc40c",
-	"milk white":               "#fefcff",
-	"milk":                     "#fdfff5",
-	"mimosa":                   "#f0bf59",
-	"mindaro":                  "#e3f988",
-	"mint cream":               "#f5fffa",
-	"mint green":               "#98ff98",
-	"mint":                     "#3eb489",
-	"mint1":                    "#00a170",
-	"mintcream":                "#f5fffa",
-	"mist blue":                "#646d7e",
-	"misty rose":               "#ffe4e1",
-	"misty rose1":              "#fbbbb9",
-	"mistyrose":                "#ffe4e1",
-	"moccasin":                 "#faebd7",
-	"moccasin1":                "#ffe4b5",
-	"moccasin2":                "#827839",
-	"mocha":                    "#493d26",
-	"moonstone blue":           "#73a9c2",
-	"mordant red 19":           "#ae0c00",
-	"moss green":               "#8a9a5b",
-	"mountain meadow":          "#30ba8f",
-	"mountbatten pink":         "#997a8d",
-	"msu green":                "#18453b",
-	"mughal green":             "#306030",
-	"mulberry":                 "#c54b8c",
-	"mustard":                  "#ffdb58",
-	"myrtle green":             "#317873",
-	"myrtle":                   "#21421e",
-	"nadeshiko pink":           "#f6adc6",
-	"napier green":             "#2a8000",
-	"navajo white":             "#ffdead",
-	"navajowhite":              "#ffdead",
-	"navy blue":                "#000080",
-	"navy peony":               "#223a5e",
-	"navy purple":              "#9457eb",
-	"navy":                     "#000080",
-	"nebula green":             "#59e817",
-	"nebulas blue":             "#3f69aa",
-	"neon carrot":              "#ffa343",
-	"neon fuchsia":             "#fe4164",
-	"neon green":               "#39ff14",
-	"neon pink":                "#f535aa",
-	"neutral gray":             "#898e8c",
-	"new car":                  "#214fc6",
-	"new york pink":            "#d7837f",
-	"niagara":                  "#578ca9",
-	"night":                    "#0c090a",
-	"non photo blue":           "#a4dded",
-	"north texas green":        "#059033",
-	"northern lights blue":     "#78c7c7",
-	"nyanza":                   "#e9ffdb",
-	"oak brown":                "#806517",
-	"ocean blue":               "#2b65ec",
-	"ocean boat blue":          "#0077be",
-	"ochre":                    "#cc7722",
-	"office green":             "#008000",
-	"oil":                      "#3b3131",
-	"old bamboo color":         "#5e644f",
-	"old gold":                 "#cfb53b",
-	"old heliotrope":           "#563c5c",
-	"old lace":                 "#fdf5e6",
-	"old lavender":             "#796878",
-	"old moss green":           "#867e36",
-	"old rose":                 "#c08081",
-	"old silver":               "#848482",
-	"oldlace":                  "#fdf5e6",
-	"olive drab #7":            "#3c341f",
-	"olive drab":               "#6b8e23",
-	"olive":                    "#808000",
-	"olivedrab":                "#6b8e23",
-	"olivine":                  "#9ab973",
-	"onyx":                     "#353839",
-	"opera mauve":              "#b784a7",
-	"orange (color wheel)":     "#ff7f00",
-	"orange (ryb)":             "#fb9902",
-	"orange gold":              "#d4a017",
-	"orange peel":              "#ff9f00",
-	"orange red":               "#ff4500",
-	"orange salmon":            "#c47451",
-	"orange tiger":             "#f96714",
-	"orange":                   "#ffa500",
-	"orangered":                "#ff4500",
-	"orchid pink":              "#f28dcd",
-	"orchid":                   "#da70d6",
-	"orioles orange":           "#fb4f14",
-	"otter brown":              "#654321",
-	"outer space":              "#414a4c",
-	"outrageous orange":        "#ff6e4a",
-	"oxford blue":              "#002147",
-	"pakistan green":           "#000060",
-	"pakistan green1":          "#006600",
-	"palatinate blue":          "#273be2",
-	"palatinate purple":        "#682860",
-	"pale aqua":                "#bcd4e6",
-	"pale blue lily":           "#cfecec",
-	"pale brown":               "#987654",
-	"pale carmine":             "#af4035",
-	"pale cerulean":            "#9bc4e2",
-	"pale chestnut":            "#ddadaf",
-	"pale copper":              "#da8a67",
-	"pale cornflower blue":     "#abcdef",
-	"pale dogwood":             "#edcdc2",
-	"pale gold":                "#e6be8a",
-	"pale goldenrod":           "#eee8aa",
-	"pale green":               "#98fb98",
-	"pale lavender":            "#dcd0ff",
-	"pale magenta":             "#f984e5",
-	"pale pink":                "#fadadd",
-	"pale robin egg blue":      "#96ded1",
-	"pale silver":              "#c9c0bb",
-	"pale spring bud":          "#ecebbd",
-	"pale taupe":               "#bc987e",
-	"pale turquoise":           "#afeeee",
-	"pale violet red":          "#db7093",
-	"pale violet red1":         "#d16587",
-	"palegoldenrod":            "#eee8aa",
-	"palegreen":                "#98fb98",
-	"paleturquoise":            "#afeeee",
-	"palevioletred":            "#db7093",
-	"paloma":                   "#9f9c99",
-	"pansy purple":             "#78184a",
-	"pantone turquoise":        "#41b6ab",
-	"paolo veronese green":     "#009b7d",
-	"papaya orange":            "#e56717",
-	"papaya whip":              "#ffefd5",
-	"papayawhip":               "#ffefd5",
-	"paradise pink":            "#e63e62",
-	"parchment":                "#ffffc2",
-	"paris green":              "#50c878",
-	"pastel blue":              "#aec6cf",
-	"pastel blue1":             "#b4cfec",
-	"pastel brown":             "#836953",
-	"pastel gray":              "#cfcfc4",
-	"pastel green":             "#77dd77",
-	"pastel magenta":           "#f49ac2",
-	"pastel orange":            "#ffb347",
-	"pastel pink":              "#dea5a4",
-	"pastel purple":            "#b39eb5",
-	"pastel red":               "#ff6961",
-	"pastel violet":            "#cb99c9",
-	"pastel yellow":            "#fdfd96",
-	"payne grey":               "#536878",
-	"peach echo":               "#f7786b",
-	"peach fuzz":               "#f87c56",
-	"peach orange":             "#ffcc99",
-	"peach pink":               "#fa9a85",
-	"peach puff":               "#ffdab9",
-	"peach yellow":             "#fadfad",
-	"peach":                    "#ffe5b4",
-	"peachpuff":                "#ffdab9",
-	"pear":                     "#d1e231",
-	"pearl aqua":               "#88d8c0",
-	"pearl":                    "#fdeef4",
-	"pearly purple":            "#b768a2",
-	"pepper stem":              "#8d9440",
-	"peridot":                  "#e6e200",
-	"periwinkle":               "#ccccff",
-	"periwinkle1":              "#e9cfec",
-	"persian blue":             "#1c39bb",
-	"persian green":            "#00a693",
-	"persian indigo":           "#32127a",
-	"persian orange":           "#d99058",
-	"persian pink":             "#f77fbe",
-	"persian red":              "#cc3333",
-	"persian rose":             "#fe28a2",
-	"persimmon":                "#ec5800",
-	"peru":                     "#cd853f",
-	"phthalo blue":             "#000f89",
-	"phthalo green":            "#123524",
-	"picton blue":              "#45b1e8",
-	"pictorial carmine":        "#c30b4e",
-	"pig pink":                 "#fdd7e4",
-	"piggy pink":               "#fddde6",
-	"pine green":               "#01796f",
-	"pine green1":              "#387c44",
-	"pine needle color":        "#454d32",
-	"pink bow":                 "#c48189",
-	"pink bubblegum":           "#ffdfdd",
-	"pink cupcake":             "#e45e9d",
-	"pink daisy":               "#e799a3",
-	"pink lace":                "#ffddf4",
-	"pink lavender":            "#d8b2d1",
-	"pink lavender1":           "#dbb1cd",
-	"pink lemonade":            "#e4287c",
-	"pink peacock":             "#c62168",
-	"pink pearl":               "#e7accf",
-	"pink rose":                "#e7a1b0",
-	"pink sherbet":             "#f78fa7",
-	"pink yarrow":              "#ce3175",
-	"pink":                     "#ffc0cb",
-	"pink1":                    "#faafbe",
-	"pistachio green":          "#9dc209",
-	"pistachio":                "#93c572",
-	"platinum":                 "#e5e4e2",
-	"plum (traditional)":       "#8e4585",
-	"plum pie":                 "#7d0541",
-	"plum purple":              "#583759",
-	"plum velvet":              "#7d0552",
-	"plum":                     "#dda0dd",
-	"plum1":                    "#b93b8f",
-	"pomelo white":             "#f9ffe3",
-	"pomelo":                   "#96a53c",
-	"pomp and power":           "#86608e",
-	"popstar":                  "#be4f62",
-	"portland orange":          "#ff5a36",
-	"powder blue":              "#b0e0e6",
-	"powder blue1":             "#c6deff",
-	"powderblue":               "#b0e0e6",
-	"primrose yellow":          "#f6d155",
-	"princess blue":            "#00539c",
-	"princeton orange":         "#ff8f00",
-	"prune":                    "#701c1c",
-	"prussian blue":            "#003153",
-	"psychedelic purple":       "#df00ff",
-	"puce":                     "#cc8899",
-	"puce1":                    "#7f5a58",
-	"pullman brown":            "#644117",
-	"pumpkin orange":           "#f87217",
-	"pumpkin":                  "#ff7518",
-	"purple (munsell)":         "#9f00c5",
-	"purple amethyst":          "#6c2dc7",
-	"purple daffodil":          "#b041ff",
-	"purple dragon":            "#c38ec7",
-	"purple flower":            "#a74ac7",
-	"purple haze":              "#4e387e",
-	"purple heart":             "#69359c",
-	"purple iris":              "#571b7e",
-	"purple jam":               "#6a287e",
-	"purple mimosa":            "#9e7bff",
-	"purple monster":           "#461b7e",
-	"purple mountain majesty":  "#9678b6",
-	"purple pizzazz":           "#fe4eda",
-	"purple sage bush":         "#7a5dc7",
-	"purple taupe":             "#50404d",
-	"purple":                   "#800080",
-	"purple1":                  "#8e35ef",
-	"purpureus":                "#9a4eae",
-	"quartz":                   "#51484f",
-	"queen blue":               "#436b95",
-	"queen pink":               "#e8ccd7",
-	"quetzal green":            "#006e6d",
-	"quiet gray":               "#bcbcbe",
-	"quinacridone magenta":     "#8e3a59",
-	"rackley":                  "#5d8aa8",
-	"radiant orchid":           "#b565a7",
-	"radical red":              "#ff355e",
-	"rajah":                    "#fbab60",
-	"raspberry glace":          "#915f6d",
-	"raspberry pink":           "#e25098",
-	"raspberry rose":           "#b3446c",
-	"raspberry sorbet":         "#d2386c",
-	"raspberry":                "#e30b5d",
-	"raw umber":                "#826644",
-	"razzle dazzle rose":       "#ff33cc",
-	"razzmatazz":               "#e3256b",
-	"razzmic berry":            "#8d4e85",
-	"rebecca purple":           "#663399",
-	"rebeccapurple":            "#663399",
-	"red (munsell)":            "#f2003c",
-	"red (ncs)":                "#c40233",
-	"red (pigment)":            "#ed1c24",
-	"red (ryb)":                "#fe2712",
-	"red brown":                "#a52a2a",
-	"red devil":                "#860111",
-	"red dirt":                 "#7f5217",
-	"red fox":                  "#c35817",
-	"red orange":               "#ff5349",
-	"red pear":                 "#7f4145",
-	"red purple":               "#e40078",
-	"red violet":               "#c71585",
-	"red wine":                 "#990012",
-	"red":                      "#ff0000",
-	"redwood":                  "#a45a52",
-	"regalia":                  "#522d80",
-	"resolution blue":          "#002387",
-	"rhythm":                   "#777696",
-	"rich black":               "#004040",
-	"rich brilliant lavender":  "#f1a7fe",
-	"rich carmine":             "#d70040",
-	"rich electric blue":       "#0892d0",
-	"rich lavender":            "#a76bcf",
-	"rich lilac":               "#b666d2",
-	"rich maroon":              "#b03060",
-	"rifle green":              "#444c38",
-	"roast coffee":             "#704241",
-	"robin egg blue":           "#00cccc",
-	"robin egg blue1":          "#bdedff",
-	"rocket metallic":          "#8a7f80",
-	"rocky road":               "#5a3e36",
-	"rogue pink":               "#c12869",
-	"roman silver":             "#838996",
-	"rose bonbon":              "#f9429e",
-	"rose ebony":               "#674846",
-	"rose gold":                "#b76e79",
-	"rose gold1":               "#ecc5c0",
-	"rose madder":              "#e32636",
-	"rose pink":                "#ff66cc",
-	"rose quartz":              "#aa98a9",
-	"rose quartz1":             "#F7CAC9",
-	"rose red":                 "#c21e56",
-	"rose taupe":               "#905d5d",
-	"rose vale":                "#ab4e52",
-	"rose":                     "#ff007f",
-	"rose1":                    "#e8adaa",
-	"rosewood":                 "#65000b",
-	"rosso corsa":              "#d40000",
-	"rosy brown":               "#bc8f8f",
-	"rosy brown1":              "#b38481",
-	"rosy finch":               "#7f4e52",
-	"rosybrown":                "#bc8f8f",
-	"royal azure":              "#0038a8",
-	"royal blue (traditional)": "#002366",
-	"royal blue (web)":         "#041690",
-	"royal blue1":              "#4169e1",
-	"royal blue":               "#2b60de",
-	"royal fuchsia":            "#ca2c92",
-	"royal purple":             "#7851a9",
-	"royalblue":                "#4169e1",
-	"rubber ducky yellow":      "#ffd801",
-	"ruber":                    "#ce4676",
-	"rubine red":               "#d10056",
-	"ruby red":                 "#9b111e",
-	"ruby":                     "#e0115f",
-	"ruddy brown":              "#bb6528",
-	"ruddy pink":               "#e18e96",
-	"ruddy":                    "#ff0028",
-	"rufous":                   "#a81c07",
-	"russet orange":            "#e47a2e",
-	"russet":                   "#80461b",
-	"russian green":            "#679267",
-	"russian violet":           "#32174d",
-	"rust":                     "#b7410e",
-	"rust1":                    "#c36241",
-	"rusty celadon":            "#898a74",
-	"rusty red":                "#da2c43",
-	"sacramento state green":   "#00563f",
-	"saddle brown":             "#8b4513",
-	"saddlebrown":              "#8b4513",
-	"safety orange":            "#ff6700",
-	"safety yellow":            "#eed202",
-	"saffron":                  "#fbb917",
-	"sage green":               "#848b79",
-	"sage":                     "#bcb88a",
-	"sailor blue":              "#2e4a62",
-	"salad green":              "#a1c935",
-	"salmon pink":              "#ff91a4",
-	"salmon":                   "#fa8072",
-	"salmon1":                  "#ff8c69",
-	"sand dollar":              "#decdbf",
-	"sand":                     "#c2b280",
-	"sandstone":                "#786d5f",
-	"sandstorm":                "#ecd540",
-	"sandy brown":              "#f4a460",
-	"sandy brown1":             "#ee9a4d",
-	"sandy taupe":              "#967117",
-	"sandybrown":               "#f4a460",
-	"sangria":                  "#92000a",
-	"sangria1":                 "#7e3817",
-	"sap green":                "#507d2a",
-	"sapphire blue":            "#0067a5",
-	"sapphire blue1":           "#2554c7",
-	"sapphire":                 "#0f52ba",
-	"sargasso sea":             "#485167",
-	"satin sheen gold":         "#cba135",
-	"scarlet":                  "#ff2400",
-	"schauss pink":             "#ff91af",
-	"school bus yellow":        "#ffd800",
-	"screamin green":           "#76ff7a",
-	"sea blue":                 "#006994",
-	"sea blue1":                "#c2dfff",
-	"sea green":                "#4e8975",
-	"sea shell":                "#fff5ee",
-	"sea turtle green":         "#438d80",
-	"seagreen":                 "#2e8b57",
-	"seal brown":               "#321414",
-	"seashell":                 "#fff5ee",
-	"seaweed green":            "#437c17",
-	"sedona":                   "#cc6600",
-	"selective yellow":         "#ffba00",
-	"sepia":                    "#704214",
-	"sepia1":                   "#7f462c",
-	"serenity":                 "#92A8D1",
-	"shaded spruce":            "#005960",
-	"shadow blue":              "#778ba5",
-	"shadow":                   "#8a795d",
-	"shampoo":                  "#ffcff1",
-	"shamrock green":           "#347c17",
-	"sheen green":              "#8fd400",
-	"shimmering blush":         "#d98695",
-	"shocking orange":          "#e55b3c",
-	"shocking pink":            "#fc0fc0",
-	"sienna1":                  "#882d17",
-	"sienna":                   "#a0522d",
-	"sienna2":                  "#8a4117",
-	"silk blue":                "#488ac7",
-	"silver chalice":           "#acacac",
-	"silver lake blue":         "#5d89ba",
-	"silver pink":              "#c4aead",
-	"silver sand":              "#bfc1c2",
-	"silver":                   "#c0c0c0",
-	"sinopia":                  "#cb410b",
-	"siskin sprout yellow":     "#7a942e",
-	"skobeloff":                "#007474",
-	"sky blue":                 "#87ceeb",
-	"sky blue1":                "#6698ff",
-	"sky magenta":              "#cf71af",
-	"skyblue":                  "#87ceeb",
-	"slate blue":               "#6a5acd",
-	"slate blue1":              "#737ca1",
-	"slate gray":               "#708090",
-	"slate gra1y":              "#657383",
-	"slate grey":               "#708090",
-	"slateblue":                "#6a5acd",
-	"slategray":                "#708090",
-	"slategrey":                "#708090",
-	"slime green":              "#bce954",
-	"smalt":                    "#003399",
-	"smitten":                  "#c84186",
-	"smoke":                    "#738276",
-	"smokey gray":              "#726e6d",
-	"smokey topaz":             "#933d41",
-	"snorkel blue":             "#034f84",
-
-	"snow":                          "#fffafa",
-	"soap":                          "#cec8ef",
-	"soldier green":                 "#545a2c",
-	"sonic silver":                  "#757575",
-	"soybean":                       "#d2c29d",
-	"space cadet":                   "#1d2951",
-	"spanish bistre":                "#80755a",
-	"spanish blue":                  "#0070b8",
-	"spanish carmine":               "#d10047",
-	"spanish crimson":               "#e51a4c",
-	"spanish gray":                  "#989898",
-	"spanish green":                 "#009150",
-	"spanish orange":                "#e86100",
-	"spanish pink":                  "#f7bfbe",
-	"spanish red":                   "#e60026",
-	"spanish sky blue":              "#00aae4",
-	"spanish violet":                "#4c2882",
-	"spanish viridian":              "#007f5c",
-	"spiro disco ball":              "#0fc0fc",
-	"spring bud":                    "#a7fc00",
-	"spring crocus":                 "#bc70a4",
-	"spring green":                  "#4aa02c",
-	"springgreen":                   "#00ff7f",
-	"st patrick blue":               "#23297a",
-	"star command blue":             "#007bb8",
-	"steel blue":                    "#4682b4",
-	"steel blue1":                   "#4863a0",
-	"steel pink":                    "#cc3366",
-	"steelblue":                     "#4682b4",
-	"stil de grain yellow":          "#fada5e",
-	"stoplight go green":            "#57e964",
-	"storeroom brown":               "#3d4035",
-	"stormcloud":                    "#4f666a",
-	"straw":                         "#e4d96f",
-	"strawberry":                    "#fc5a8d",
-	"sugar almond":                  "#935529",
-	"sun yellow":                    "#ffe87c",
-	"sunglow":                       "#ffcc33",
-	"sunray":                        "#e3ab57",
-	"sunrise orange":                "#e67451",
-	"sunset orange":                 "#fd5e53",
-	"super pink":                    "#cf6ba9",
-	"sweet corn":                    "#f0ead6",
-	"sweet lilac":                   "#e8b5ce",
-	"tan brown":                     "#ece5b6",
-	"tan":                           "#d2b48c",
-	"tangelo":                       "#f94d00",
-	"tangerine tango":               "#f05442",
-	"tangerine":                     "#e78a61",
-	"tango pink":                    "#e4717a",
-	"taupe gray":                    "#8b8589",
-	"taupe":                         "#483c32",
-	"tawny port":                    "#672e3b",
-	"tea green":                     "#d0f0c0",
-	"tea green1":                    "#ccfb5d",
-	"tea rose":                      "#f4c2c2",
-	"teal blue":                     "#367588",
-	"teal deer":                     "#99e6b3",
-	"teal green":                    "#00827f",
-	"teal":                          "#008080",
-	"telemagenta":                   "#cf3476",
-	"tenné (tawny)":                 "#cd5700",
-	"terra cotta":                   "#e2725b",
-	"terrarium moss":                "#616247",
-	"thistle":                       "#d8bfd8",
-	"thistle1":                      "#d2b9d3",
-	"thulian pink":                  "#de6fa1",
-	"tickle me pink":                "#fc89ac",
-	"tiffany blue":                  "#0abab5",
-	"tiffany blue1":                 "#81d8d0",
-	"tiger orange":                  "#c88141",
-	"tigerlily":                     "#e4583e",
-	"tigers eye":                    "#e08d3c",
-	"timberwolf":                    "#dbd7d2",
-	"titanium yellow":               "#eee600",
-	"toffee":                        "#755139",
-	"tofu":                          "#eae6da",
-	"tomato":                        "#ff6347",
-	"toolbox":                       "#746cc0",
-	"topaz":                         "#ffc87c",
-	"tractor red":                   "#fd0e35",
-	"trolley grey":                  "#808080",
-	"tron blue":                     "#7dfdfe",
-	"tropical rain forest":          "#00755e",
-	"true blue":                     "#0073cf",
-	"true red":                      "#c02034",
-	"tufts blue":                    "#417dc1",
-	"tulip pink":                    "#c25a7c",
-	"tulip":                         "#ff878d",
-	"tumbleweed":                    "#deaa88",
-	"turkish rose":                  "#b57281",
-	"turmeric":                      "#fe840e",
-	"turquoise blue":                "#00ffef",
-	"turquoise green":               "#a0d6b4",
-	"turquoise1":                    "#30d5c8",
-	"turquoise":                     "#40e0d0",
-	"turquoise2":                    "#43c6db",
-	"tuscan brown":                  "#6f4e37",
-	"tuscan red":                    "#7c4848",
-	"tuscan tan":                    "#a67b5b",
-	"tuscan":                        "#fad6a5",
-	"tuscany":                       "#c09999",
-	"twilight lavender":             "#8a496b",
-	"tyrian purple":                 "#66023c",
-	"tyrian purple1":                "#c45aec",
-	"ua blue":                       "#0033aa",
-	"ua red":                        "#d9004c",
-	"ube":                           "#8878c3",
-	"ucla blue":                     "#536895",
-	"ucla gold":                     "#ffb300",
-	"ufo green":                     "#3cd070",
-	"ultimate grey":                 "#939597",
-	"ultra pink":                    "#ff6fff",
-	"ultra violet":                  "#5f4b8b",
-	"ultramarine blue":              "#4166f5",
-	"ultramarine":                   "#120a8f",
-	"umber":                         "#635147",
-	"unbleached silk":               "#ffddca",
-	"united nations blue":           "#5b92e5",
-	"university of california gold": "#b78727",
-	"unmellow yellow":               "#ffff66",
-	"up forest green":               "#014421",
-	"up maroon":                     "#7b1113",
-	"upsdell red":                   "#ae2029",
-	"urobilin":                      "#e1ad21",
-	"usafa blue":                    "#004f98",
-	"usc cardinal":                  "#990000",
-	"usc gold":                      "#ffcc00",
-	"utah crimson":                  "#d3003f",
-	"valentine red":                 "#e55451",
-	"valiant poppy":                 "#bd3d3a",
-	"vampire gray":                  "#565051",
-	"vanilla custard":               "#f3e0be",
-	"vanilla ice":                   "#f38fa9",
-	"vanilla":                       "#f3e5ab",
-	"vegas gold":                    "#c5b358",
-	"velvet maroon":                 "#7e354d",
-	"venetian red":                  "#c80815",
-	"venom green":                   "#728c00",
-	"verdigris":                     "#43b3ae",
-	"vermilion":                     "#e34234",
-	"veronica":                      "#a020f0",
-	"very peri":                     "#6667ab",
-	"viola purple":                  "#7e587e",
-	"violet (color wheel)":          "#7f00ff",
-	"violet (ryb)":                  "#8601af",
-	"violet blue":                   "#324ab2",
-	"violet red":                    "#f75394",
-	"violet red1":                   "#f6358a",
-	"violet":                        "#8f00ff",
-	"violet2":                       "#ee82ee",
-	"violet1":                       "#8d38c9",
-	"viridian green":                "#009698",
-	"viridian":                      "#40826d",
-	"vivid auburn":                  "#922724",
-	"vivid burgundy":                "#9f1d35",
-	"vivid cerise":                  "#da1d81",
-	"vivid lime green":              "#a6d608",
-	"vivid magenta":                 "#ba2649",
-	"vivid orchid":                  "#cc00ff",
-	"vivid sky blue":                "#00ccff",
-	"vivid tangerine":               "#ffa089",
-	"vivid violet":                  "#9f00ff",
-	"volt":                          "#ceff00",
-	"warm black":                    "#004242",
-	"warm sand":                     "#c0ab8e",
-	"water":                         "#ebf4fa",
-	"watermelon pink":               "#fc6c85",
-	"watermelon yellow":             "#eeff1b",
-	"waterspout":                    "#a4f4f9",
-	"wenge":                         "#645452",
-	"wheat":                         "#f5deb3",
-	"white smoke":                   "#f5f5f5",
-	"white":                         "#ffffff",
-	"whitesmoke":                    "#f5f5f5",
-	"wild blue yonder":              "#a2add0",
-	"wild orchid":                   "#d470a2",
-	"wild strawberry":               "#ff43a4",
-	"wild watermelon":               "#fc6c85",
-	"willow dye":                    "#8c9e5e",
-	"willow":                        "#9a8b4f",
-	"willpower orange":              "#fd5800",
-	"windows blue":                  "#357ec7",
-	"windsor tan":                   "#a75502",
-	"wine dregs":                    "#673147",
-	"wine":                          "#722f37",
-	"wisteria purple":               "#c6aec7",
-	"wisteria":                      "#c9a0dc",
-	"wood brown":                    "#c19a6b",
-	"wood":                          "#966f33",
-	"xanadu":                        "#738678",
-	"yale blue":                     "#0f4d92",
-	"yankees blue":                  "#1c2841",
-	"yellow (munsell)":              "#efcc00",
-	"yellow (ryb)":                  "#fefe33",
-	"yellow green":                  "#52d017",
-	"yellow orange":                 "#ffae42",
-	"yellow rose":                   "#fff000",
-	"yellow":                        "#ffff00",
-	"yellow-green (crayola)":        " #c5e384",
-	"yellowgreen":                   "#9acd32",
-	"zaffre":                        "#0014a8",
-	"zombie green":                  "#54c571",
+// colorTable is the built-in palette. Each entry has the color's name,
+// its display name, its hex value, the source it was taken from (see
+// Sources) and any aliases: other spellings of the same name, such as
+// the CSS keyword "aliceblue" for "alice blue", that StringToColor also
+// accepts. The palette RandomColor chooses from holds the names only,
+// never the aliases.
+//
+// All names are lowercase for comparisons. The display name is the
+// name as it is written for people: its words capitalized and separated
+// by spaces, with the small words ("of", "and", "de") in lowercase and
+// acronyms in capitals. A CSS keyword that runs the words together
+// ("royalblue") is an alias of the spaced name ("royal blue"). A name
+// (or alias) must not appear twice; init panics if one does. Different
+// names may share a hex value: they are all kept for StringToColor, and
+// the palette keeps the first in name order. Where sources give one
+// name different colors, the CSS color, if there is one, has the plain
+// name, and the others name their source or kind in parentheses
+// ("khaki (computer hope)", "azure (color wheel)"). Older spellings
+// with a digit ("khaki2") are kept as aliases. Spellings of the same
+// name with the same value belong in the aliases of one entry, and the
+// name is the spelling WITH spaces.
+var colorTable = []ColorInfo{
+	{"acid green", "Acid Green", "#b0bf1a", SourceOther, nil},
+	{"aero blue", "Aero Blue", "#c9ffe5", SourceOther, nil},
+	{"african violet", "African Violet", "#b284be", SourceOther, nil},
+	{"air force blue (usaf)", "Air Force Blue (USAF)", "#00308f", SourceOther, nil},
+	{"air superiority blue", "Air Superiority Blue", "#72a0c1", SourceOther, nil},
+	{"alabama crimson", "Alabama Crimson", "#a32638", SourceOther, nil},
+	{"alabama crimson (dark)", "Alabama Crimson (Dark)", "#af002a", SourceOther, []string{"alabama crimson1"}},
+	{"algae green", "Algae Green", "#64e986", SourceOther, nil},
+	{"alice blue", "Alice Blue", "#f0f8ff", SourceCSS, []string{"aliceblue"}},
+	{"alien green", "Alien Green", "#6cc417", SourceOther, nil},
+	{"alloy orange", "Alloy Orange", "#c46210", SourceOther, nil},
+	{"almond", "Almond", "#efdecd", SourceOther, nil},
+	{"almond buff", "Almond Buff", "#d1b894", SourceOther, nil},
+	{"almost mauve", "Almost Mauve", "#eadedb", SourceOther, nil},
+	{"amaranth", "Amaranth", "#e52b50", SourceOther, nil},
+	{"amaranth pink", "Amaranth Pink", "#f19cbb", SourceOther, nil},
+	{"amaranth purple", "Amaranth Purple", "#ab274f", SourceOther, nil},
+	{"amazon", "Amazon", "#3b7a57", SourceOther, nil},
+	{"amber (sae/ece)", "Amber (SAE/ECE)", "#ff7e00", SourceOther, nil},
+	{"american rose", "American Rose", "#ff033e", SourceOther, nil},
+	{"amethyst", "Amethyst", "#9966cc", SourceOther, nil},
+	{"amethyst orchid", "Amethyst Orchid", "#926aa6", SourceOther, nil},
+	{"amzn_0001", "amzn_0001", "#630f32", SourceOther, nil}, // red matte
+	{"amzn_0002", "amzn_0002", "#0f6340", SourceOther, nil}, // greenish matte
+	{"android green", "Android Green", "#a4c639", SourceOther, nil},
+	{"anti flash white", "Anti Flash White", "#f2f3f4", SourceOther, nil},
+	{"antique brass", "Antique Brass", "#cd9575", SourceCrayola, nil},
+	{"antique bronze", "Antique Bronze", "#665d1e", SourceOther, nil},
+	{"antique fuchsia", "Antique Fuchsia", "#915c83", SourceOther, nil},
+	{"antique ruby", "Antique Ruby", "#841b2d", SourceOther, nil},
+	{"antique white", "Antique White", "#faebd7", SourceCSS, []string{"antiquewhite"}},
+	{"apple green", "Apple Green", "#8db600", SourceOther, nil},
+	{"apricot", "Apricot", "#fbceb1", SourceOther, nil},
+	{"aqua", "Aqua", "#00ffff", SourceCSSBasic, []string{"cyan"}},
+	{"aqua sky", "Aqua Sky", "#7ac5c5", SourcePantone, nil},
+	{"aquamarine", "Aquamarine", "#7fffd4", SourceCSS, nil},
+	{"arcadia", "Arcadia", "#00a591", SourceOther, nil},
+	{"arctic lime", "Arctic Lime", "#d0ff14", SourceOther, nil},
+	{"army brown", "Army Brown", "#827b60", SourceOther, nil},
+	{"army green", "Army Green", "#4b5320", SourceOther, nil},
+	{"arsenic", "Arsenic", "#3b444b", SourceOther, nil},
+	{"artichoke", "Artichoke", "#8f9779", SourceOther, nil},
+	{"ash gray", "Ash Gray", "#666362", SourceOther, nil},
+	{"ash grey", "Ash Grey", "#b2beb5", SourceOther, nil},
+	{"asparagus", "Asparagus", "#87a96b", SourceCrayola, nil},
+	{"aspen gold", "Aspen Gold", "#ffd662", SourceOther, nil},
+	{"atomic tangerine", "Atomic Tangerine", "#ff9966", SourceCrayola, nil},
+	{"aureolin", "Aureolin", "#fdee00", SourceOther, nil},
+	{"aurometalsaurus", "Aurometalsaurus", "#6e7f80", SourceOther, nil},
+	{"autumn maple", "Autumn Maple", "#d2691e", SourceOther, nil},
+	{"avocado", "Avocado", "#568203", SourceOther, nil},
+	{"avocado green", "Avocado Green", "#b2c248", SourceOther, nil},
+	{"aztech purple", "Aztech Purple", "#893bff", SourceOther, nil},
+	{"azure", "Azure", "#f0ffff", SourceCSS, nil},
+	{"azure (color wheel)", "Azure (Color Wheel)", "#007fff", SourceOther, []string{"azure1"}},
+	{"azure mist", "Azure Mist", "#f0ffff", SourceOther, nil},
+	{"baby blue", "Baby Blue", "#95b9c7", SourceOther, nil},
+	{"baby blue (light)", "Baby Blue (Light)", "#89cff0", SourceOther, []string{"baby blue1"}},
+	{"baby blue eyes", "Baby Blue Eyes", "#a1caf1", SourceOther, nil},
+	{"baby powder", "Baby Powder", "#fefefa", SourceOther, nil},
+	{"ball blue", "Ball Blue", "#21abcd", SourceOther, nil},
+	{"ballet slipper", "Ballet Slipper", "#f3d6e4", SourceOther, nil},
+	{"banana mania", "Banana Mania", "#fae7b5", SourceCrayola, nil},
+	{"banana yellow", "Banana Yellow", "#ffe135", SourceOther, nil},
+	{"barbie pink", "Barbie Pink", "#e0218a", SourceOther, nil},
+	{"barn red", "Barn Red", "#7c0a02", SourceOther, nil},
+	{"bashful pink", "Bashful Pink", "#c25283", SourceOther, nil},
+	{"basket ball orange", "Basket Ball Orange", "#f88158", SourceOther, nil},
+	{"battleship gray", "Battleship Gray", "#848482", SourceOther, nil},
+	{"bazaar", "Bazaar", "#98777b", SourceOther, nil},
+	{"bean red", "Bean Red", "#f75d59", SourceOther, nil},
+	{"beaver", "Beaver", "#9f8170", SourceCrayola, nil},
+	{"bee yellow", "Bee Yellow", "#e9ab17", SourceOther, nil},
+	{"beer", "Beer", "#fbb117", SourceOther, nil},
+	{"beetle green", "Beetle Green", "#4c787e", SourceOther, nil},
+	{"beige", "Beige", "#f5f5dc", SourceCSS, nil},
+	{"big dip o’ruby", "Big Dip O'Ruby", "#9c2542", SourceOther, nil},
+	{"biking red", "Biking Red", "#77212e", SourceOther, nil},
+	{"bisque", "Bisque", "#ffe4c4", SourceCSS, nil},
+	{"bistre", "Bistre", "#3d2b1f", SourceOther, nil},
+	{"bitter lemon", "Bitter Lemon", "#cae00d", SourceOther, nil},
+	{"bitter lime", "Bitter Lime", "#648c11", SourceOther, nil},
+	{"bitter lime (light)", "Bitter Lime (Light)", "#bfff00", SourceOther, []string{"bitter lime1"}},
+	{"bittersweet", "Bittersweet", "#fe6f5e", SourceOther, nil},
+	{"bittersweet shimmer", "Bittersweet Shimmer", "#bf4f51", SourceOther, nil},
+	{"black", "Black", "#000000", SourceCSSBasic, nil},
+	{"black bean", "Black Bean", "#3d0c02", SourceOther, nil},
+	{"black cat", "Black Cat", "#413839", SourceOther, nil},
+	{"black cow", "Black Cow", "#4c4646", SourceOther, nil},
+	{"black eel", "Black Eel", "#463e3f", SourceOther, nil},
+	{"black leather jacket", "Black Leather Jacket", "#253529", SourceOther, nil},
+	{"blanched almond", "Blanched Almond", "#ffebcd", SourceCSS, []string{"blanchedalmond"}},
+	{"blast off bronze", "Blast Off Bronze", "#a57164", SourceOther, nil},
+	{"bleu de france", "Bleu de France", "#318ce7", SourceOther, nil},
+	{"blizzard blue", "Blizzard Blue", "#ace5ee", SourceCrayola, nil},
+	{"blond", "Blond", "#faf0be", SourceOther, nil},
+	{"blonde", "Blonde", "#fbf6d9", SourceOther, nil},
+	{"blood red", "Blood Red", "#7e3517", SourceOther, nil},
+	{"blooming dahlia", "Blooming Dahlia", "#ec9787", SourceOther, nil},
+	{"blossom pink", "Blossom Pink", "#f9b7ff", SourceOther, nil},
+	{"blue", "Blue", "#0000ff", SourceCSSBasic, nil},
+	{"blue (crayola)", "Blue (Crayola)", "#1f75fe", SourceCrayola, nil},
+	{"blue (munsell)", "Blue (Munsell)", "#0093af", SourceMunsell, nil},
+	{"blue (ncs)", "Blue (NCS)", "#0087bd", SourceNCS, nil},
+	{"blue (pigment)", "Blue (Pigment)", "#333399", SourceOther, nil},
+	{"blue (ryb)", "Blue (RYB)", "#0247fe", SourceRYB, nil},
+	{"blue angel", "Blue Angel", "#b7ceec", SourceOther, nil},
+	{"blue bell", "Blue Bell", "#a2a2d0", SourceCrayola, nil},
+	{"blue diamond", "Blue Diamond", "#4ee2ec", SourceOther, nil},
+	{"blue dress", "Blue Dress", "#157dec", SourceOther, nil},
+	{"blue eyes", "Blue Eyes", "#1569c7", SourceOther, nil},
+	{"blue gray", "Blue Gray", "#98afc7", SourceOther, nil},
+	{"blue green", "Blue Green", "#0d98ba", SourceOther, nil},
+	{"blue green (computer hope)", "Blue Green (Computer Hope)", "#7bccb5", SourceOther, []string{"blue green1"}},
+	{"blue hosta", "Blue Hosta", "#77bfc7", SourceOther, nil},
+	{"blue iris", "Blue Iris", "#595ca1", SourcePantone, nil},
+	{"blue ivy", "Blue Ivy", "#3090c7", SourceOther, nil},
+	{"blue jay", "Blue Jay", "#2b547e", SourceOther, nil},
+	{"blue koi", "Blue Koi", "#659ec7", SourceOther, nil},
+	{"blue lagoon", "Blue Lagoon", "#8eebec", SourceOther, nil},
+	{"blue lotus", "Blue Lotus", "#6960ec", SourceOther, nil},
+	{"blue orchid", "Blue Orchid", "#1f45fc", SourceOther, nil},
+	{"blue ribbon", "Blue Ribbon", "#306eff", SourceOther, nil},
+	{"blue sapphire", "Blue Sapphire", "#126180", SourceOther, nil},
+	{"blue turquoise", "Blue Turquoise", "#4fb0ae", SourcePantone, nil},
+	{"blue violet", "Blue Violet", "#8a2be2", SourceCSS, []string{"blueviolet"}},
+	{"blue whale", "Blue Whale", "#342d7e", SourceOther, nil},
+	{"blue yonder", "Blue Yonder", "#5072a7", SourceOther, nil},
+	{"blue zircon", "Blue Zircon", "#57feff", SourceOther, nil},
+	{"blueberry", "Blueberry", "#4f86f7", SourceOther, nil},
+	{"blueberry blue", "Blueberry Blue", "#0041c2", SourceOther, nil},
+	{"bluebonnet", "Bluebonnet", "#1c1cf0", SourceOther, nil},
+	{"bluestone", "Bluestone", "#577284", SourceOther, nil},
+	{"blush", "Blush", "#de5d83", SourceOther, nil},
+	{"blush pink", "Blush Pink", "#e6a9ec", SourceOther, nil},
+	{"blush red", "Blush Red", "#e56e94", SourceOther, nil},
+	{"bondi blue", "Bondi Blue", "#0095b6", SourceOther, nil},
+	{"bone", "Bone", "#e3dac9", SourceOther, nil},
+	{"bored accent green", "Bored Accent Green", "#dde26a", SourceOther, nil},
+	{"boston university red", "Boston University Red", "#cc0000", SourceOther, nil},
+	{"bottle green", "Bottle Green", "#006a4e", SourceOther, nil},
+	{"boysenberry", "Boysenberry", "#873260", SourceOther, nil},
+	{"brandeis blue", "Brandeis Blue", "#0070ff", SourceOther, nil},
+	{"brass", "Brass", "#b5a642", SourceOther, nil},
+	{"brick red", "Brick Red", "#cb4154", SourceCrayola, nil},
+	{"bright cerulean", "Bright Cerulean", "#1dacd6", SourceOther, nil},
+	{"bright gold", "Bright Gold", "#fdd017", SourceOther, nil},
+	{"bright green", "Bright Green", "#66ff00", SourceOther, nil},
+	{"bright lavender", "Bright Lavender", "#bf94e4", SourceOther, nil},
+	{"bright lilac", "Bright Lilac", "#d891ef", SourceOther, nil},
+	{"bright maroon", "Bright Maroon", "#c32148", SourceOther, nil},
+	{"bright navy blue", "Bright Navy Blue", "#1974d2", SourceOther, nil},
+	{"bright neon pink", "Bright Neon Pink", "#f433ff", SourceOther, nil},
+	{"bright turquoise", "Bright Turquoise", "#08e8de", SourceOther, nil},
+	{"bright ube", "Bright Ube", "#d19fe8", SourceOther, nil},
+	{"brilliant rose", "Brilliant Rose", "#ff55a3", SourceOther, nil},
+	{"brink pink", "Brink Pink", "#fb607f", SourceOther, nil},
+	{"british racing green", "British Racing Green", "#004225", SourceOther, nil},
+	{"bronze", "Bronze", "#cd7f32", SourceOther, nil},
+	{"bronze yellow", "Bronze Yellow", "#737000", SourceOther, nil},
+	{"brown", "Brown", "#a52a2a", SourceCSS, nil},
+	{"brown (traditional)", "Brown (Traditional)", "#964b00", SourceOther, nil},
+	{"brown bear", "Brown Bear", "#835c3b", SourceOther, nil},
+	{"brown granite", "Brown Granite", "#615550", SourceOther, nil},
+	{"brown sugar", "Brown Sugar", "#e2a76f", SourceOther, nil},
+	{"bubble gum", "Bubble Gum", "#ffc1cc", SourceOther, nil},
+	{"bubbles", "Bubbles", "#e7feff", SourceOther, nil},
+	{"bud green", "Bud Green", "#7bb661", SourceOther, nil},
+	{"buff", "Buff", "#f0dc82", SourceOther, nil},
+	{"bulgarian rose", "Bulgarian Rose", "#480607", SourceOther, nil},
+	{"bullet shell", "Bullet Shell", "#af9b60", SourceOther, nil},
+	{"burgundy", "Burgundy", "#800020", SourceOther, nil},
+	{"burgundy (computer hope)", "Burgundy (Computer Hope)", "#8c001a", SourceOther, []string{"burgundy1"}},
+	{"burly wood", "Burly Wood", "#deb887", SourceCSS, []string{"burlywood"}},
+	{"burnt coral", "Burnt Coral", "#e9897e", SourceOther, nil},
+	{"burnt orange", "Burnt Orange", "#cc5500", SourceOther, nil},
+	{"burnt pink", "Burnt Pink", "#c12267", SourceOther, nil},
+	{"burnt umber", "Burnt Umber", "#8a3324", SourceOther, nil},
+	{"butter rum", "Butter Rum", "#c48f65", SourceOther, nil},
+	{"buttercream", "Buttercream", "#efe1ce", SourceOther, nil},
+	{"buttercup", "Buttercup", "#fae03c", SourceOther, nil},
+	{"butterfly blue", "Butterfly Blue", "#38acec", SourceOther, nil},
+	{"byzantine", "Byzantine", "#bd33a4", SourceOther, nil},
+	{"byzantium", "Byzantium", "#702963", SourceOther, nil},
+	{"cadet", "Cadet", "#536872", SourceOther, nil},
+	{"cadet blue", "Cadet Blue", "#5f9ea0", SourceCSS, []string{"cadetblue"}},
+	{"cadet grey", "Cadet Grey", "#91a3b0", SourceOther, nil},
+	{"cadillac pink", "Cadillac Pink", "#e38aae", SourceOther, nil},
+	{"cadmium green", "Cadmium Green", "#006b3c", SourceOther, nil},
+	{"cadmium orange", "Cadmium Orange", "#ed872d", SourceOther, nil},
+	{"cadmium red", "Cadmium Red", "#e30022", SourceOther, nil},
+	{"cadmium yellow", "Cadmium Yellow", "#fff600", SourceOther, nil},
+	{"café noir", "Café Noir", "#4b3621", SourceOther, nil},
+	{"cal poly green", "Cal Poly Green", "#1e4d2b", SourceOther, nil},
+	{"calamansi", "Calamansi", "#fcffa4", SourceOther, nil},
+	{"cambridge blue", "Cambridge Blue", "#a3c1ad", SourceOther, nil},
+	{"camel brown", "Camel Brown", "#c19a6b", SourceOther, nil},
+	{"cameo pink", "Cameo Pink", "#efbbcc", SourceOther, nil},
+	{"camouflage green", "Camouflage Green", "#78866b", SourceOther, nil},
+	{"canary yellow", "Canary Yellow", "#ffef00", SourceOther, nil},
+	{"candy apple red", "Candy Apple Red", "#ff0800", SourceOther, nil},
+	{"cantaloupe", "Cantaloupe", "#ffa62f", SourceOther, nil},
+	{"caput mortuum", "Caput Mortuum", "#592720", SourceOther, nil},
+	{"caramel", "Caramel", "#c68e17", SourceOther, nil},
+	{"carbon gray", "Carbon Gray", "#625d5d", SourceOther, nil},
+	{"cardinal", "Cardinal", "#c41e3a", SourceOther, nil},
+	{"caribbean green", "Caribbean Green", "#00cc99", SourceCrayola, nil},
+	{"carmine", "Carmine", "#960018", SourceOther, nil},
+	{"carmine pink", "Carmine Pink", "#eb4c42", SourceOther, nil},
+	{"carmine red", "Carmine Red", "#ff0038", SourceOther, nil},
+	{"carnation pink", "Carnation Pink", "#ffa6c9", SourceOther, nil},
+	{"carnation pink (computer hope)", "Carnation Pink (Computer Hope)", "#f778a1", SourceOther, []string{"carnation pink1"}},
+	{"carolina blue", "Carolina Blue", "#99badd", SourceOther, nil},
+	{"carrot orange", "Carrot Orange", "#ed9121", SourceOther, nil},
+	{"catalina blue", "Catalina Blue", "#062a78", SourceOther, nil},
+	{"catawba", "Catawba", "#703642", SourceOther, nil},
+	{"cedar chest", "Cedar Chest", "#c95a49", SourceOther, nil},
+	{"ceil", "Ceil", "#92a1cf", SourceOther, nil},
+	{"celadon", "Celadon", "#ace1af", SourceOther, nil},
+	{"celadon green", "Celadon Green", "#2f847c", SourceOther, nil},
+	{"celeste", "Celeste", "#50ebec", SourceOther, nil},
+	{"celestial blue", "Celestial Blue", "#4997d0", SourceOther, nil},
+	{"cerise pink", "Cerise Pink", "#ec3b83", SourceOther, nil},
+	{"cerulean", "Cerulean", "#007ba7", SourceOther, nil},
+	{"cerulean (pantone)", "Cerulean (Pantone)", "#9bb7d6", SourcePantone, []string{"cerulean1"}},
+	{"cerulean blue", "Cerulean Blue", "#2a52be", SourceOther, nil},
+	{"cerulean frost", "Cerulean Frost", "#6d9bc3", SourceOther, nil},
+	{"ceylon yellow", "Ceylon Yellow", "#d5ae41", SourceOther, nil},
+	{"cg blue", "Cg Blue", "#007aa5", SourceOther, nil},
+	{"cg red", "Cg Red", "#e03c31", SourceOther, nil},
+	{"chamoisee", "Chamoisee", "#a0785a", SourceOther, nil},
+	{"champagne", "Champagne", "#f7e7ce", SourceOther, nil},
+	{"charcoal", "Charcoal", "#36454f", SourceOther, nil},
+	{"charcoal (computer hope)", "Charcoal (Computer Hope)", "#34282c", SourceOther, []string{"charcoal1"}},
+	{"charleston green", "Charleston Green", "#232b2b", SourceOther, nil},
+	{"chartreuse", "Chartreuse", "#7fff00", SourceCSS, nil},
+	{"chartreuse (computer hope)", "Chartreuse (Computer Hope)", "#8afb17", SourceOther, []string{"chartreuse2"}},
+	{"chartreuse (traditional)", "Chartreuse (Traditional)", "#dfff00", SourceOther, []string{"chartreuse1"}},
+	{"cherry", "Cherry", "#de3163", SourceOther, nil},
+	{"cherry blossom pink", "Cherry Blossom Pink", "#ffb7c5", SourceOther, nil},
+	{"cherry red", "Cherry Red", "#c24641", SourceOther, nil},
+	{"cherry tomato", "Cherry Tomato", "#e94b3c", SourceOther, nil},
+	{"chestnut", "Chestnut", "#954535", SourceOther, nil},
+	{"chestnut red", "Chestnut Red", "#c34a2c", SourceOther, nil},
+	{"chili oil", "Chili Oil", "#944743", SourceOther, nil},
+	{"chili pepper", "Chili Pepper", "#9c1b31", SourcePantone, nil},
+	{"chilli pepper", "Chilli Pepper", "#c11b17", SourceOther, nil},
+	{"china rose", "China Rose", "#a8516e", SourceOther, nil},
+	{"chinese green", "Chinese Green", "#d0db61", SourceOther, nil},
+	{"chinese red", "Chinese Red", "#aa381e", SourceOther, nil},
+	{"chinese violet", "Chinese Violet", "#856088", SourceOther, nil},
+	{"chocolate", "Chocolate", "#d2691e", SourceCSS, nil},
+	{"chocolate (computer hope)", "Chocolate (Computer Hope)", "#c85a17", SourceOther, []string{"chocolate1"}},
+	{"chocolate (traditional)", "Chocolate (Traditional)", "#7b3f00", SourceOther, nil},
+	{"chrome yellow", "Chrome Yellow", "#ffa700", SourceOther, nil},
+	{"cinereous", "Cinereous", "#98817b", SourceOther, nil},
+	{"cinnamon", "Cinnamon", "#c58917", SourceOther, nil},
+	{"citrine", "Citrine", "#e4d00a", SourceOther, nil},
+	{"citron", "Citron", "#9fa91f", SourceOther, nil},
+	{"claret", "Claret", "#7f1734", SourceOther, nil},
+	{"classic blue", "Classic Blue", "#0f4c81", SourcePantone, nil},
+	{"classic rose", "Classic Rose", "#fbcce7", SourceOther, nil},
+	{"cloudy gray", "Cloudy Gray", "#6d6968", SourceOther, nil},
+	{"clover green", "Clover Green", "#3ea055", SourceOther, nil},
+	{"coal", "Coal", "#7cb9e8", SourceOther, nil},
+	{"cobalt", "Cobalt", "#0047ab", SourceOther, nil},
+	{"cobalt blue", "Cobalt Blue", "#0020c2", SourceOther, nil},
+	{"cocoa brown", "Cocoa Brown", "#d2691e", SourceOther, nil},
+	{"coconut", "Coconut", "#965a3e", SourceOther, nil},
+	{"coconut milk", "Coconut Milk", "#f0ede5", SourceOther, nil},
+	{"coffee", "Coffee", "#6f4e37", SourceOther, nil},
+	{"columbia blue", "Columbia Blue", "#9bddff", SourceOther, nil},
+	{"columbia blue (computer hope)", "Columbia Blue (Computer Hope)", "#87afc7", SourceOther, []string{"columbia blue1"}},
+	{"construction cone orange", "Construction Cone Orange", "#f87431", SourceOther, nil},
+	{"cookie brown", "Cookie Brown", "#c7a317", SourceOther, nil},
+	{"cool black", "Cool Black", "#002e63", SourceOther, nil},
+	{"copper", "Copper", "#b87333", SourceOther, nil},
+	{"copper penny", "Copper Penny", "#ad6f69", SourceOther, nil},
+	{"copper red", "Copper Red", "#cb6d51", SourceOther, nil},
+	{"copper rose", "Copper Rose", "#996666", SourceOther, nil},
+	{"coquelicot", "Coquelicot", "#ff3800", SourceOther, nil},
+	{"coral", "Coral", "#ff7f50", SourceCSS, nil},
+	{"coral blue", "Coral Blue", "#afdcec", SourceOther, nil},
+	{"coral pink", "Coral Pink", "#f88379", SourceOther, nil},
+	{"coral red", "Coral Red", "#ff4040", SourceOther, nil},
+	{"cordovan", "Cordovan", "#893f45", SourceOther, nil},
+	{"corn flower blue", "Corn Flower Blue", "#6495ed", SourceCSS, []string{"cornflower blue", "cornflowerblue"}},
+	{"corn silk", "Corn Silk", "#fff8dc", SourceCSS, []string{"cornsilk"}},
+	{"corn yellow", "Corn Yellow", "#fff380", SourceOther, nil},
+	{"cornell red", "Cornell Red", "#b31b1b", SourceOther, nil},
+	{"cosmic latte", "Cosmic Latte", "#fff8e7", SourceOther, nil},
+	{"cotton candy", "Cotton Candy", "#ffbcd9", SourceOther, nil},
+	{"cotton candy (computer hope)", "Cotton Candy (Computer Hope)", "#fcdfff", SourceOther, []string{"cotton candy1"}},
+	{"cranberry", "Cranberry", "#9f000f", SourceOther, nil},
+	{"cream", "Cream", "#fffdd0", SourceOther, nil},
+	{"crimson", "Crimson", "#dc143c", SourceCSS, nil},
+	{"crimson (computer hope)", "Crimson (Computer Hope)", "#e238ec", SourceOther, []string{"crimson1"}},
+	{"crimson glory", "Crimson Glory", "#be0032", SourceOther, nil},
+	{"crocus petal", "Crocus Petal", "#be9ec9", SourceOther, nil},
+	{"crocus purple", "Crocus Purple", "#9172ec", SourceOther, nil},
+	{"crystal blue", "Crystal Blue", "#5cb3ff", SourceOther, nil},
+	{"crème de pêche", "Crème de Pêche", "#f5d6c6", SourceOther, nil},
+	{"cyan (process)", "Cyan (Process)", "#00b7eb", SourceOther, nil},
+	{"cyan opaque", "Cyan Opaque", "#92c7c7", SourceOther, nil},
+	{"cyber grape", "Cyber Grape", "#58427c", SourceOther, nil},
+	{"cyber yellow", "Cyber Yellow", "#ffd300", SourceOther, nil},
+	{"daffodil", "Daffodil", "#ffff31", SourceOther, nil},
+	{"dandelion", "Dandelion", "#f0e130", SourceCrayola, nil},
+	{"dark blue", "Dark Blue", "#00008b", SourceCSS, []string{"darkblue"}},
+	{"dark blue gray", "Dark Blue Gray", "#666699", SourceOther, nil},
+	{"dark byzantium", "Dark Byzantium", "#5d3954", SourceOther, nil},
+	{"dark candy apple red", "Dark Candy Apple Red", "#a40000", SourceOther, nil},
+	{"dark carnation pink", "Dark Carnation Pink", "#c12283", SourceOther, nil},
+	{"dark cerulean", "Dark Cerulean", "#08457e", SourceOther, nil},
+	{"dark cheddar", "Dark Cheddar", "#e08119", SourceOther, nil},
+	{"dark chestnut", "Dark Chestnut", "#986960", SourceOther, nil},
+	{"dark coral", "Dark Coral", "#cd5b45", SourceOther, nil},
+	{"dark cyan", "Dark Cyan", "#008b8b", SourceCSS, []string{"darkcyan"}},
+	{"dark forest green", "Dark Forest Green", "#254117", SourceOther, nil},
+	{"dark goldenrod", "Dark Goldenrod", "#b8860b", SourceCSS, []string{"darkgoldenrod"}},
+	{"dark goldenrod (computer hope)", "Dark Goldenrod (Computer Hope)", "#af7817", SourceOther, nil},
+	{"dark gray", "Dark Gray", "#a9a9a9", SourceCSS, []string{"dark grey", "darkgray", "darkgrey"}},
+	{"dark green", "Dark Green", "#006400", SourceCSS, []string{"darkgreen"}},
+	{"dark imperial blue", "Dark Imperial Blue", "#00416a", SourceOther, nil},
+	{"dark khaki", "Dark Khaki", "#bdb76b", SourceCSS, []string{"darkkhaki"}},
+	{"dark lavender", "Dark Lavender", "#734f96", SourceOther, nil},
+	{"dark lemon lime", "Dark Lemon Lime", "#8bbe1b", SourceOther, nil},
+	{"dark liver", "Dark Liver", "#534b4f", SourceOther, nil},
+	{"dark magenta", "Dark Magenta", "#8b008b", SourceCSS, []string{"darkmagenta"}},
+	{"dark midnight blue", "Dark Midnight Blue", "#000036", SourceOther, nil},
+	{"dark midnight blue (light)", "Dark Midnight Blue (Light)", "#003366", SourceOther, []string{"dark midnight blue1"}},
+	{"dark moss green", "Dark Moss Green", "#4a5d23", SourceOther, nil},
+	{"dark olive green", "Dark Olive Green", "#556b2f", SourceCSS, []string{"darkolivegreen"}},
+	{"dark orange", "Dark Orange", "#ff8c00", SourceCSS, []string{"darkorange"}},
+	{"dark orange (computer hope)", "Dark Orange (Computer Hope)", "#f88017", SourceOther, []string{"dark orange1"}},
+	{"dark orchid", "Dark Orchid", "#9932cc", SourceCSS, nil},
+	{"dark orchid (computer hope)", "Dark Orchid (Computer Hope)", "#7d1b7e", SourceOther, []string{"dark orchid1"}},
+	{"dark pastel blue", "Dark Pastel Blue", "#779ecb", SourceOther, nil},
+	{"dark pastel green", "Dark Pastel Green", "#03c03c", SourceOther, nil},
+	{"dark pastel purple", "Dark Pastel Purple", "#966fd6", SourceOther, nil},
+	{"dark pastel red", "Dark Pastel Red", "#c23b22", SourceOther, nil},
+	{"dark pink", "Dark Pink", "#e75480", SourceOther, nil},
+	{"dark powder blue", "Dark Powder Blue", "#000039", SourceOther, nil},
+	{"dark puce", "Dark Puce", "#4f3a3c", SourceOther, nil},
+	{"dark raspberry", "Dark Raspberry", "#872657", SourceOther, nil},
+	{"dark red", "Dark Red", "#8b0000", SourceCSS, []string{"darkred"}},
+	{"dark salmon", "Dark Salmon", "#e9967a", SourceCSS, []string{"darksalmon"}},
+	{"dark scarlet", "Dark Scarlet", "#560319", SourceOther, nil},
+	{"dark sea green", "Dark Sea Green", "#8fbc8f", SourceCSS, []string{"darkseagreen"}},
+	{"dark sea green (computer hope)", "Dark Sea Green (Computer Hope)", "#8bb381", SourceOther, []string{"dark sea green1"}},
+	{"dark sienna", "Dark Sienna", "#3c1414", SourceOther, nil},
+	{"dark sky blue", "Dark Sky Blue", "#8cbed6", SourceOther, nil},
+	{"dark slate blue", "Dark Slate Blue", "#483d8b", SourceCSS, []string{"darkslateblue"}},
+	{"dark slate blue (computer hope)", "Dark Slate Blue (Computer Hope)", "#2b3856", SourceOther, []string{"dark slate blue1"}},
+	{"dark slate gray", "Dark Slate Gray", "#2f4f4f", SourceCSS, []string{"darkslategray", "dark slate grey", "darkslategrey"}},
+	{"dark slate gray (computer hope)", "Dark Slate Gray (Computer Hope)", "#25383c", SourceOther, nil},
+	{"dark spring green", "Dark Spring Green", "#177245", SourceOther, nil},
+	{"dark tan", "Dark Tan", "#918151", SourceOther, nil},
+	{"dark tangerine", "Dark Tangerine", "#ffa812", SourceOther, nil},
+	{"dark terra cotta", "Dark Terra Cotta", "#cc4e5c", SourceOther, nil},
+	{"dark turquoise", "Dark Turquoise", "#00ced1", SourceCSS, []string{"darkturquoise"}},
+	{"dark turquoise (computer hope)", "Dark Turquoise (Computer Hope)", "#3b9c9c", SourceOther, []string{"dark turquoise1"}},
+	{"dark vanilla", "Dark Vanilla", "#d1bea8", SourceOther, nil},
+	{"dark violet", "Dark Violet", "#9400d3", SourceCSS, []string{"darkviolet"}},
+	{"dark violet (computer hope)", "Dark Violet (Computer Hope)", "#842dce", SourceOther, []string{"dark violet1"}},
+	{"dark yellow", "Dark Yellow", "#9b870c", SourceOther, nil},
+	{"dartmouth green", "Dartmouth Green", "#00703c", SourceOther, nil},
+	{"davy’s grey", "Davy's Grey", "#555555", SourceOther, nil},
+	{"day sky blue", "Day Sky Blue", "#82caff", SourceOther, nil},
+	{"debian red", "Debian Red", "#d70a53", SourceOther, nil},
+	{"deep carmine", "Deep Carmine", "#a9203e", SourceOther, nil},
+	{"deep carmine pink", "Deep Carmine Pink", "#ef3038", SourceOther, nil},
+	{"deep carrot orange", "Deep Carrot Orange", "#e9692c", SourceOther, nil},
+	{"deep cerise", "Deep Cerise", "#da3287", SourceOther, nil},
+	{"deep chestnut", "Deep Chestnut", "#b94e48", SourceOther, nil},
+	{"deep fuchsia", "Deep Fuchsia", "#c154c1", SourceOther, nil},
+	{"deep jungle green", "Deep Jungle Green", "#004b49", SourceOther, nil},
+	{"deep lemon", "Deep Lemon", "#f5c71a", SourceOther, nil},
+	{"deep lilac", "Deep Lilac", "#9955bb", SourceOther, nil},
+	{"deep magenta", "Deep Magenta", "#cc00cc", SourceOther, nil},
+	{"deep peach", "Deep Peach", "#ffcba4", SourceOther, nil},
+	{"deep pink", "Deep Pink", "#ff1493", SourceCSS, []string{"deeppink"}},
+	{"deep pink (computer hope)", "Deep Pink (Computer Hope)", "#f52887", SourceOther, []string{"deep pink1"}},
+	{"deep puce", "Deep Puce", "#a95c68", SourceOther, nil},
+	{"deep ruby", "Deep Ruby", "#843f5b", SourceOther, nil},
+	{"deep saffron", "Deep Saffron", "#ff9933", SourceOther, nil},
+	{"deep sky blue", "Deep Sky Blue", "#00bfff", SourceCSS, []string{"deepskyblue"}},
+	{"deep sky blue (computer hope)", "Deep Sky Blue (Computer Hope)", "#3bb9ff", SourceOther, []string{"deep sky blue1"}},
+	{"deep space sparkle", "Deep Space Sparkle", "#4a646c", SourceOther, nil},
+	{"deep taupe", "Deep Taupe", "#7e5e60", SourceOther, nil},
+	{"deep tuscan red", "Deep Tuscan Red", "#66424d", SourceOther, nil},
+	{"deer", "Deer", "#ba8759", SourceOther, nil},
+	{"denim", "Denim", "#1560bd", SourceCrayola, nil},
+	{"denim blue", "Denim Blue", "#79baec", SourceOther, nil},
+	{"denim dark blue", "Denim Dark Blue", "#151b8d", SourceOther, nil},
+	{"desert mist", "Desert Mist", "#e0b589", SourceOther, nil},
+	{"desert sand", "Desert Sand", "#edc9af", SourceCrayola, nil},
+	{"desire", "Desire", "#ea3c53", SourceOther, nil},
+	{"diamond", "Diamond", "#b9f2ff", SourceOther, nil},
+	{"dim gray", "Dim Gray", "#696969", SourceCSS, []string{"dim grey", "dimgray", "dimgrey"}},
+	{"dimorphotheca magenta", "Dimorphotheca Magenta", "#e3319d", SourceOther, nil},
+	{"dirt", "Dirt", "#9b7653", SourceOther, nil},
+	{"dodger blue", "Dodger Blue", "#1e90ff", SourceCSS, []string{"dodgerblue"}},
+	{"dodger blue (computer hope)", "Dodger Blue (Computer Hope)", "#1589ff", SourceOther, []string{"dodger blue1"}},
+	{"dogwood rose", "Dogwood Rose", "#d71868", SourceOther, nil},
+	{"dollar bill", "Dollar Bill", "#85bb65", SourceOther, nil},
+	{"dollar bill green", "Dollar Bill Green", "#85bb65", SourceOther, nil},
+	{"donkey brown", "Donkey Brown", "#664c28", SourceOther, nil},
+	{"dragon green", "Dragon Green", "#6afb92", SourceOther, nil},
+	{"duke blue", "Duke Blue", "#00009c", SourceOther, nil},
+	{"dull purple", "Dull Purple", "#7f525d", SourceOther, nil},
+	{"dust storm", "Dust Storm", "#e5ccc9", SourceOther, nil},
+	{"dutch white", "Dutch White", "#efdfbb", SourceOther, nil},
+	{"earth blue", "Earth Blue", "#0000a0", SourceOther, nil},
+	{"earth yellow", "Earth Yellow", "#e1a95f", SourceOther, nil},
+	{"ebony", "Ebony", "#555d50", SourceOther, nil},
+	{"eclipse", "Eclipse", "#343148", SourceOther, nil},
+	{"eden", "Eden", "#264e36", SourceOther, nil},
+	{"eggplant", "Eggplant", "#614051", SourceOther, nil},
+	{"eggshell", "Eggshell", "#f0ead6", SourceOther, nil},
+	{"egyptian blue", "Egyptian Blue", "#1034a6", SourceOther, nil},
+	{"electric blue", "Electric Blue", "#7df9ff", SourceOther, nil},
+	{"electric blue (computer hope)", "Electric Blue (Computer Hope)", "#9afeff", SourceOther, []string{"electric blue1"}},
+	{"electric crimson", "Electric Crimson", "#ff003f", SourceOther, nil},
+	{"electric cyan", "Electric Cyan", "#00ffff", SourceOther, nil},
+	{"electric indigo", "Electric Indigo", "#6f00ff", SourceOther, nil},
+	{"electric lavender", "Electric Lavender", "#f4bbff", SourceOther, nil},
+	{"electric lime", "Electric Lime", "#ccff00", SourceCrayola, nil},
+	{"electric purple", "Electric Purple", "#bf00ff", SourceOther, nil},
+	{"electric ultramarine", "Electric Ultramarine", "#3f00ff", SourceOther, nil},
+	{"emerald", "Emerald", "#169c78", SourcePantone, nil},
+	{"emerald green", "Emerald Green", "#5ffb17", SourceOther, nil},
+	{"eminence", "Eminence", "#6c3082", SourceOther, nil},
+	{"emperador", "Emperador", "#6c4f3d", SourceOther, nil},
+	{"english green", "English Green", "#1b4d3e", SourceOther, nil},
+	{"english lavender", "English Lavender", "#b48395", SourceOther, nil},
+	{"english red", "English Red", "#ab4b52", SourceOther, nil},
+	{"eton blue", "Eton Blue", "#96c8a2", SourceOther, nil},
+	{"eucalyptus", "Eucalyptus", "#44d7a8", SourceOther, nil},
+	{"evening blue", "Evening Blue", "#2a293e", SourceOther, nil},
+	{"fall leaf brown", "Fall Leaf Brown", "#c8b560", SourceOther, nil},
+	{"falu red", "Falu Red", "#801818", SourceOther, nil},
+	{"fandango", "Fandango", "#b53389", SourceOther, nil},
+	{"fandango pink", "Fandango Pink", "#de5285", SourceOther, nil},
+	{"fawn", "Fawn", "#e5aa70", SourceOther, nil},
+	{"feldgrau", "Feldgrau", "#4d5d53", SourceOther, nil},
+	{"fern green", "Fern Green", "#667c26", SourceOther, nil},
+	{"ferrari red", "Ferrari Red", "#ff2800", SourceOther, nil},
+	{"field drab", "Field Drab", "#6c541e", SourceOther, nil},
+	{"fiesta", "Fiesta", "#dd4132", SourceOther, nil},
+	{"fire brick", "Fire Brick", "#b22222", SourceCSS, []string{"firebrick"}},
+	{"fire engine red", "Fire Engine Red", "#ce2029", SourceOther, nil},
+	{"firebrick (computer hope)", "Firebrick (Computer Hope)", "#800517", SourceOther, []string{"firebrick1"}},
+	{"flame", "Flame", "#f2552c", SourceOther, nil},
+	{"flamingo pink", "Flamingo Pink", "#fc8eac", SourceOther, nil},
+	{"flamingo pink (computer hope)", "Flamingo Pink (Computer Hope)", "#f9a7b0", SourceOther, []string{"flamingo pink1"}},
+	{"flattery", "Flattery", "#6b4423", SourceOther, nil},
+	{"flavescent", "Flavescent", "#f7e98e", SourceOther, nil},
+	{"flax", "Flax", "#eedc82", SourceOther, nil},
+	{"flirt", "Flirt", "#a2006d", SourceOther, nil},
+	{"floral white", "Floral White", "#fffaf0", SourceCSS, []string{"floralwhite"}},
+	{"fluorescent orange", "Fluorescent Orange", "#ffbf00", SourceOther, nil},
+	{"fluorescent pink", "Fluorescent Pink", "#ff1493", SourceOther, nil},
+	{"folly", "Folly", "#ff004f", SourceOther, nil},
+	{"forest green", "Forest Green", "#228b22", SourceCSS, []string{"forestgreen"}},
+	{"forest green (computer hope)", "Forest Green (Computer Hope)", "#4e9258", SourceOther, nil},
+	{"french bistre", "French Bistre", "#856d4d", SourceOther, nil},
+	{"french blue", "French Blue", "#0072bb", SourceOther, nil},
+	{"french blue (pantone)", "French Blue (Pantone)", "#0072b5", SourceOther, []string{"french blue1"}},
+	{"french fuchsia", "French Fuchsia", "#fd3f92", SourceOther, nil},
+	{"french lime", "French Lime", "#9efd38", SourceOther, nil},
+	{"french mauve", "French Mauve", "#d473d4", SourceOther, nil},
+	{"french pink", "French Pink", "#fd6c9e", SourceOther, nil},
+	{"french puce", "French Puce", "#4e1609", SourceOther, nil},
+	{"french raspberry", "French Raspberry", "#c72c48", SourceOther, nil},
+	{"french rose", "French Rose", "#f64a8a", SourceOther, nil},
+	{"french sky blue", "French Sky Blue", "#77b5fe", SourceOther, nil},
+	{"french wine", "French Wine", "#ac1e44", SourceOther, nil},
+	{"fresh air", "Fresh Air", "#a6e7ff", SourceOther, nil},
+	{"frog green", "Frog Green", "#99c68e", SourceOther, nil},
+	{"fruit dove", "Fruit Dove", "#ce5b78", SourceOther, nil},
+	{"fuchsia", "Fuchsia", "#ff00ff", SourceCSSBasic, []string{"magenta"}},
+	{"fuchsia pink", "Fuchsia Pink", "#ff77ff", SourceOther, nil},
+	{"fuchsia purple", "Fuchsia Purple", "#cc397b", SourceOther, nil},
+	{"fuchsia rose", "Fuchsia Rose", "#c74375", SourcePantone, nil},
+	{"fulvous", "Fulvous", "#e48400", SourceOther, nil},
+	{"fuschia rose", "Fuschia Rose", "#c94476", SourceOther, nil},
+	{"fuzzy wuzzy", "Fuzzy Wuzzy", "#cc6666", SourceCrayola, nil},
+	{"gainsboro", "Gainsboro", "#dcdcdc", SourceCSS, nil},
+	{"galaxy blue", "Galaxy Blue", "#2a4b7c", SourceOther, nil},
+	{"gamboge", "Gamboge", "#e49b0f", SourceOther, nil},
+	{"generic viridian", "Generic Viridian", "#007f66", SourceOther, nil},
+	{"ghost white", "Ghost White", "#f8f8ff", SourceCSS, []string{"ghostwhite"}},
+	{"giants orange", "Giants Orange", "#fe5a1d", SourceOther, nil},
+	{"gin", "Gin", "#d8e4bc", SourceOther, nil},
+	{"ginger", "Ginger", "#b06500", SourceOther, nil},
+	{"ginger brown", "Ginger Brown", "#c9be62", SourceOther, nil},
+	{"glacial blue ice", "Glacial Blue Ice", "#368bc1", SourceOther, nil},
+	{"glaucous", "Glaucous", "#6082b6", SourceOther, nil},
+	{"glitter", "Glitter", "#e6e8fa", SourceOther, nil},
+	{"go green", "Go Green", "#00ab66", SourceOther, nil},
+	{"gold", "Gold", "#ffd700", SourceCSS, nil},
+	{"gold (metallic)", "Gold (Metallic)", "#d4af37", SourceOther, nil},
+	{"gold fusion", "Gold Fusion", "#85754e", SourceOther, nil},
+	{"golden brown", "Golden Brown", "#eac117", SourceOther, nil},
+	{"golden lime", "Golden Lime", "#9c9a40", SourceOther, nil},
+	{"golden poppy", "Golden Poppy", "#fcc200", SourceOther, nil},
+	{"golden yellow", "Golden Yellow", "#ffdf00", SourceOther, nil},
+	{"goldenrod", "Goldenrod", "#daa520", SourceCSS, nil},
+	{"goldenrod (computer hope)", "Goldenrod (Computer Hope)", "#edda74", SourceOther, []string{"goldenrod1"}},
+	{"granite", "Granite", "#837e7c", SourceOther, nil},
+	{"granny smith apple", "Granny Smith Apple", "#a8e4a0", SourceCrayola, nil},
+	{"grape", "Grape", "#6f2da8", SourceOther, nil},
+	{"grape (computer hope)", "Grape (Computer Hope)", "#5e5a80", SourceOther, []string{"grape1"}},
+	{"grapefruit", "Grapefruit", "#dc381f", SourceOther, nil},
+	{"gray", "Gray", "#808080", SourceCSSBasic, []string{"grey"}},
+	{"gray (computer hope)", "Gray (Computer Hope)", "#736f6e", SourceOther, []string{"gray2"}},
+	{"gray (x11 gray)", "Gray (X11 Gray)", "#bebebe", SourceOther, nil},
+	{"gray asparagus", "Gray Asparagus", "#465945", SourceOther, nil},
+	{"gray blue", "Gray Blue", "#8c92ac", SourceOther, nil},
+	{"gray cloud", "Gray Cloud", "#b6b6b4", SourceOther, nil},
+	{"gray dolphin", "Gray Dolphin", "#5c5858", SourceOther, nil},
+	{"gray goose", "Gray Goose", "#d1d0ce", SourceOther, nil},
+	{"gray wolf", "Gray Wolf", "#504a4b", SourceOther, nil},
+	{"grayish turquoise", "Grayish Turquoise", "#5e7d7e", SourceOther, nil},
+	{"green", "Green", "#008000", SourceCSSBasic, nil},
+	{"green (crayola)", "Green (Crayola)", "#1cac78", SourceCrayola, nil},
+	{"green (munsell)", "Green (Munsell)", "#00a877", SourceMunsell, nil},
+	{"green (ncs)", "Green (NCS)", "#009f6b", SourceNCS, nil},
+	{"green (pigment)", "Green (Pigment)", "#00a550", SourceOther, nil},
+	{"green (ryb)", "Green (RYB)", "#66b032", SourceRYB, nil},
+	{"green apple", "Green Apple", "#4cc417", SourceOther, nil},
+	{"green ash", "Green Ash", "#a0daa9", SourceOther, nil},
+	{"green flash", "Green Flash", "#79c753", SourceOther, nil},
+	{"green onion", "Green Onion", "#6aa121", SourceOther, nil},
+	{"green peas", "Green Peas", "#89c35c", SourceOther, nil},
+	{"green snake", "Green Snake", "#6cbb3c", SourceOther, nil},
+	{"green thumb", "Green Thumb", "#b5eaaa", SourceOther, nil},
+	{"green yellow", "Green Yellow", "#adff2f", SourceCSS, []string{"greenyellow"}},
+	{"green yellow (computer hope)", "Green Yellow (Computer Hope)", "#b1fb17", SourceOther, nil},
+	{"greenery", "Greenery", "#88b04b", SourcePantone, nil},
+	{"greenish blue", "Greenish Blue", "#307d7e", SourceOther, nil},
+	{"grenadine", "Grenadine", "#dc4c46", SourceOther, nil},
+	{"grullo", "Grullo", "#a99a86", SourceOther, nil},
+	{"guacamole", "Guacamole", "#797b3a", SourceOther, nil},
+	{"gunmetal", "Gunmetal", "#2c3539", SourceOther, nil},
+	{"halloween orange", "Halloween Orange", "#e66c2c", SourceOther, nil},
+	{"han blue", "Han Blue", "#446ccf", SourceOther, nil},
+	{"han purple", "Han Purple", "#5218fa", SourceOther, nil},
+	{"hansa yellow", "Hansa Yellow", "#e9d66b", SourceOther, nil},
+	{"harbor mist", "Harbor Mist", "#b4b7ba", SourceOther, nil},
+	{"harlequin", "Harlequin", "#3fff00", SourceOther, nil},
+	{"harvard crimson", "Harvard Crimson", "#c90016", SourceOther, nil},
+	{"harvest gold", "Harvest Gold", "#ede275", SourceOther, nil},
+	{"hazel green", "Hazel Green", "#617c58", SourceOther, nil},
+	{"hazelnut", "Hazelnut", "#cfb095", SourceOther, nil},
+	{"heliotrope", "Heliotrope", "#df73ff", SourceOther, nil},
+	{"heliotrope purple", "Heliotrope Purple", "#d462ff", SourceOther, nil},
+	{"hollywood cerise", "Hollywood Cerise", "#f400a1", SourceOther, nil},
+	{"honey dew", "Honey Dew", "#f0fff0", SourceCSS, []string{"honeydew"}},
+	{"honeysuckle", "Honeysuckle", "#da4f70", SourcePantone, nil},
+	{"honolulu blue", "Honolulu Blue", "#006db0", SourceOther, nil},
+	{"honolulu blue (light)", "Honolulu Blue (Light)", "#007fbf", SourceOther, []string{"honolulu blue1"}},
+	{"hooker green", "Hooker Green", "#49796b", SourceOther, nil},
+	{"hot magenta", "Hot Magenta", "#ff1dce", SourceCrayola, nil},
+	{"hot pink", "Hot Pink", "#ff69b4", SourceCSS, []string{"hotpink"}},
+	{"hot pink (computer hope)", "Hot Pink (Computer Hope)", "#f660ab", SourceOther, []string{"hot pink1"}},
+	{"hummingbird green", "Hummingbird Green", "#7fe817", SourceOther, nil},
+	{"hunter green", "Hunter Green", "#355e3b", SourceOther, nil},
+	{"iceberg", "Iceberg", "#71a6d2", SourceOther, nil},
+	{"iceberg (computer hope)", "Iceberg (Computer Hope)", "#56a5ec", SourceOther, []string{"iceberg1"}},
+	{"iced coffee", "Iced Coffee", "#b18f6a", SourceOther, nil},
+	{"icterine", "Icterine", "#fcf75e", SourceOther, nil},
+	{"iguana green", "Iguana Green", "#9cb071", SourceOther, nil},
+	{"illuminating", "Illuminating", "#f5df4d", SourcePantone, nil},
+	{"illuminating emerald", "Illuminating Emerald", "#319177", SourceOther, nil},
+	{"imperial", "Imperial", "#602f6b", SourceOther, nil},
+	{"imperial blue", "Imperial Blue", "#002395", SourceOther, nil},
+	{"imperial red", "Imperial Red", "#ed2939", SourceOther, nil},
+	{"inchworm", "Inchworm", "#b2ec5d", SourceCrayola, nil},
+	{"independence", "Independence", "#4c516d", SourceOther, nil},
+	{"india green", "India Green", "#138808", SourceOther, nil},
+	{"indian red", "Indian Red", "#cd5c5c", SourceCSS, []string{"indianred"}},
+	{"indian yellow", "Indian Yellow", "#e3a857", SourceOther, nil},
+	{"indigo", "Indigo", "#4b0082", SourceCSS, nil},
+	{"inkwell", "Inkwell", "#363945", SourceOther, nil},
+	{"international klein blue", "International Klein Blue", "#002fa7", SourceOther, nil},
+	{"international orange", "International Orange", "#ff4f00", SourceOther, nil},
+	{"international orange (engineering)", "International Orange (Engineering)", "#ba160c", SourceOther, nil},
+	{"international orange (golden gate bridge)", "International Orange (Golden Gate Bridge)", "#c0362c", SourceOther, nil},
+	{"iridium", "Iridium", "#3d3c3a", SourceOther, nil},
+	{"iris", "Iris", "#5a4fcf", SourceOther, nil},
+	{"isabelline", "Isabelline", "#f4f0ec", SourceOther, nil},
+	{"islamic green", "Islamic Green", "#009900", SourceOther, nil},
+	{"island paradise", "Island Paradise", "#95dee3", SourceOther, nil},
+	{"italian sky blue", "Italian Sky Blue", "#b2ffff", SourceOther, nil},
+	{"ivory", "Ivory", "#fffff0", SourceCSS, nil},
+	{"jade", "Jade", "#00a86b", SourceOther, nil},
+	{"jade green", "Jade Green", "#5efb6e", SourceOther, nil},
+	{"japanese carmine", "Japanese Carmine", "#9d2933", SourceOther, nil},
+	{"japanese indigo", "Japanese Indigo", "#264348", SourceOther, nil},
+	{"japanese violet", "Japanese Violet", "#5b3256", SourceOther, nil},
+	{"jasmine purple", "Jasmine Purple", "#a23bec", SourceOther, nil},
+	{"jasper", "Jasper", "#d73b3e", SourceOther, nil},
+	{"jazzberry jam", "Jazzberry Jam", "#a50b5e", SourceCrayola, nil},
+	{"jeans blue", "Jeans Blue", "#a0cfec", SourceOther, nil},
+	{"jelly bean", "Jelly Bean", "#da614e", SourceOther, nil},
+	{"jellyfish", "Jellyfish", "#46c7c7", SourceOther, nil},
+	{"jester red", "Jester Red", "#9e1030", SourceOther, nil},
+	{"jet", "Jet", "#343434", SourceOther, nil},
+	{"jet gray", "Jet Gray", "#616d7e", SourceOther, nil},
+	{"jonquil", "Jonquil", "#f4ca16", SourceOther, nil},
+	{"jordy blue", "Jordy Blue", "#8ab9f1", SourceOther, nil},
+	{"june bud", "June Bud", "#bdda57", SourceOther, nil},
+	{"jungle green", "Jungle Green", "#29ab87", SourceOther, nil},
+	{"jungle green (computer hope)", "Jungle Green (Computer Hope)", "#347c2c", SourceOther, []string{"jungle green1"}},
+	{"kale", "Kale", "#5a7247", SourceOther, nil},
+	{"kelly green", "Kelly Green", "#4cc552", SourceOther, nil},
+	{"kenyan copper", "Kenyan Copper", "#7c1c05", SourceOther, nil},
+	{"keppel", "Keppel", "#3ab09e", SourceOther, nil},
+	{"key lime", "Key Lime", "#e8f48c", SourceOther, nil},
+	{"khaki", "Khaki", "#f0e68c", SourceCSS, []string{"khaki1"}},
+	{"khaki (computer hope)", "Khaki (Computer Hope)", "#ada96e", SourceOther, []string{"khaki2"}},
+	{"khaki (traditional)", "Khaki (Traditional)", "#c3b091", SourceOther, nil},
+	{"khaki rose", "Khaki Rose", "#c5908e", SourceOther, nil},
+	{"kobi", "Kobi", "#e79fc4", SourceOther, nil},
+	{"kombu green", "Kombu Green", "#354230", SourceOther, nil},
+	{"ku crimson", "Ku Crimson", "#e8000d", SourceOther, nil},
+	{"la salle green", "La Salle Green", "#087830", SourceOther, nil},
+	{"languid lavender", "Languid Lavender", "#d6cadd", SourceOther, nil},
+	{"lapis blue", "Lapis Blue", "#15317e", SourceOther, nil},
+	{"lapis blue (pantone)", "Lapis Blue (Pantone)", "#004b8d", SourceOther, []string{"lapis blue1"}},
+	{"lapis lazuli", "Lapis Lazuli", "#26619c", SourceOther, nil},
+	{"laser lemon", "Laser Lemon", "#fefe22", SourceCrayola, nil},
+	{"laurel green", "Laurel Green", "#a9ba9d", SourceOther, nil},
+	{"lava", "Lava", "#cf1020", SourceOther, nil},
+	{"lava red", "Lava Red", "#e42217", SourceOther, nil},
+	{"lavender", "Lavender", "#e6e6fa", SourceCSS, nil},
+	{"lavender (floral)", "Lavender (Floral)", "#b57edc", SourceOther, nil},
+	{"lavender blue", "Lavender Blue", "#e3e4fa", SourceOther, nil},
+	{"lavender blush", "Lavender Blush", "#fff0f5", SourceCSS, []string{"lavenderblush"}},
+	{"lavender gray", "Lavender Gray", "#c4c3d0", SourceOther, nil},
+	{"lavender magenta", "Lavender Magenta", "#ee82ee", SourceOther, nil},
+	{"lavender mist", "Lavender Mist", "#e6e6fa", SourceOther, nil},
+	{"lavender pink", "Lavender Pink", "#fbaed2", SourceOther, nil},
+	{"lavender pinocchio", "Lavender Pinocchio", "#ebdde2", SourceOther, nil},
+	{"lavender purple", "Lavender Purple", "#967bb6", SourceOther, nil},
+	{"lavender rose", "Lavender Rose", "#fba0e3", SourceOther, nil},
+	{"lawn green", "Lawn Green", "#7cfc00", SourceCSS, []string{"lawngreen"}},
+	{"lawn green (computer hope)", "Lawn Green (Computer Hope)", "#87f717", SourceOther, nil},
+	{"lemon", "Lemon", "#fff700", SourceOther, nil},
+	{"lemon chiffon", "Lemon Chiffon", "#fffacd", SourceCSS, []string{"lemonchiffon"}},
+	{"lemon curry", "Lemon Curry", "#cca01d", SourceOther, nil},
+	{"lemon glacier", "Lemon Glacier", "#fdff00", SourceOther, nil},
+	{"lemon lime", "Lemon Lime", "#e3ff00", SourceOther, nil},
+	{"lemon meringue", "Lemon Meringue", "#f6eabe", SourceOther, nil},
+	{"lemon yellow", "Lemon Yellow", "#fff44f", SourceOther, nil},
+	{"liberty", "Liberty", "#545aa7", SourceOther, nil},
+	{"light apricot", "Light Apricot", "#fdd5b1", SourceOther, nil},
+	{"light aquamarine", "Light Aquamarine", "#93ffe8", SourceOther, nil},
+	{"light blue", "Light Blue", "#add8e6", SourceCSS, []string{"lightblue"}},
+	{"light blue (computer hope)", "Light Blue (Computer Hope)", "#addfff", SourceOther, []string{"light blue1"}},
+	{"light brown", "Light Brown", "#b5651d", SourceOther, nil},
+	{"light carmine pink", "Light Carmine Pink", "#e66771", SourceOther, nil},
+	{"light coral", "Light Coral", "#f08080", SourceCSS, []string{"lightcoral"}},
+	{"light cornflower blue", "Light Cornflower Blue", "#93ccea", SourceOther, nil},
+	{"light crimson", "Light Crimson", "#f56991", SourceOther, nil},
+	{"light cyan", "Light Cyan", "#e0ffff", SourceCSS, []string{"lightcyan"}},
+	{"light deep pink", "Light Deep Pink", "#ff5ccd", SourceOther, nil},
+	{"light fuchsia pink", "Light Fuchsia Pink", "#f984ef", SourceOther, nil},
+	{"light goldenrod yellow", "Light Goldenrod Yellow", "#fafad2", SourceCSS, []string{"lightgoldenrodyellow"}},
+	{"light gray", "Light Gray", "#d3d3d3", SourceCSS, []string{"light grey", "lightgray", "lightgrey"}},
+	{"light green", "Light Green", "#90ee90", SourceCSS, []string{"lightgreen"}},
+	{"light hot pink", "Light Hot Pink", "#ffb3de", SourceOther, nil},
+	{"light jade", "Light Jade", "#c3fdb8", SourceOther, nil},
+	{"light medium orchid", "Light Medium Orchid", "#d39bcb", SourceOther, nil},
+	{"light moss green", "Light Moss Green", "#addfad", SourceOther, nil},
+	{"light orchid", "Light Orchid", "#e6a8d7", SourceOther, nil},
+	{"light pastel purple", "Light Pastel Purple", "#b19cd9", SourceOther, nil},
+	{"light pink", "Light Pink", "#ffb6c1", SourceCSS, []string{"lightpink"}},
+	{"light pink (computer hope)", "Light Pink (Computer Hope)", "#faafba", SourceOther, []string{"light pink1"}},
+	{"light red ochre", "Light Red Ochre", "#e97451", SourceOther, nil},
+	{"light salmon", "Light Salmon", "#ffa07a", SourceCSS, []string{"lightsalmon"}},
+	{"light salmon pink", "Light Salmon Pink", "#ff9999", SourceOther, nil},
+	{"light sea green", "Light Sea Green", "#20b2aa", SourceCSS, []string{"lightseagreen"}},
+	{"light sea green (computer hope)", "Light Sea Green (Computer Hope)", "#3ea99f", SourceOther, []string{"light sea green1"}},
+	{"light sky blue", "Light Sky Blue", "#87cefa", SourceCSS, []string{"lightskyblue"}},
+	{"light slate", "Light Slate", "#ccffff", SourceOther, nil},
+	{"light slate blue", "Light Slate Blue", "#736aff", SourceOther, nil},
+	{"light slate gray", "Light Slate Gray", "#778899", SourceCSS, []string{"light slate grey", "lightslategray", "lightslategrey"}},
+	{"light slate gray (computer hope)", "Light Slate Gray (Computer Hope)", "#6d7b8d", SourceOther, []string{"light slate gray1"}},
+	{"light steel blue", "Light Steel Blue", "#b0c4de", SourceCSS, []string{"lightsteelblue", "light steel blue1"}},
+	{"light steel blue (computer hope)", "Light Steel Blue (Computer Hope)", "#728fce", SourceOther, nil},
+	{"light taupe", "Light Taupe", "#b38b6d", SourceOther, nil},
+	{"light thulian pink", "Light Thulian Pink", "#e68fac", SourceOther, nil},
+	{"light yellow", "Light Yellow", "#ffffe0", SourceCSS, []string{"lightyellow"}},
+	{"lilac", "Lilac", "#c8a2c8", SourceOther, nil},
+	{"lilac grey", "Lilac Grey", "#9896a4", SourceOther, nil},
+	{"lime", "Lime", "#00ff00", SourceCSSBasic, nil},
+	{"lime green", "Lime Green", "#32cd32", SourceCSS, []string{"limegreen"}},
+	{"lime green (computer hope)", "Lime Green (Computer Hope)", "#41a317", SourceOther, nil},
+	{"lime pulp", "Lime Pulp", "#d1e189", SourceOther, nil},
+	{"lime punch", "Lime Punch", "#bfd641", SourceOther, nil},
+	{"limelight", "Limelight", "#f1ea7f", SourceOther, nil},
+	{"limerick", "Limerick", "#9dc209", SourceOther, nil},
+	{"limpet shell", "Limpet Shell", "#98ddde", SourceOther, nil},
+	{"lincoln green", "Lincoln Green", "#195905", SourceOther, nil},
+	{"linen", "Linen", "#faf0e6", SourceCSS, nil},
+	{"lipstick pink", "Lipstick Pink", "#c48793", SourceOther, nil},
+	{"little boy blue", "Little Boy Blue", "#6ca0dc", SourceOther, nil},
+	{"little boy blue (pantone)", "Little Boy Blue (Pantone)", "#6f9fd8", SourceOther, []string{"little boy blue1"}},
+	{"liver chestnut", "Liver Chestnut", "#987456", SourceOther, nil},
+	{"livid", "Livid", "#6699cc", SourceOther, nil},
+	{"living coral", "Living Coral", "#ff6f61", SourcePantone, nil},
+	{"love red", "Love Red", "#e41b17", SourceOther, nil},
+	{"lovely purple", "Lovely Purple", "#7f38ec", SourceOther, nil},
+	{"lumber", "Lumber", "#ffe4cd", SourceOther, nil},
+	{"lust", "Lust", "#e62020", SourceOther, nil},
+	{"macaroni and cheese", "Macaroni and Cheese", "#f2bb66", SourceCrayola, nil},
+	{"macaw blue green", "Macaw Blue Green", "#43bfc7", SourceOther, nil},
+	{"magenta (dye)", "Magenta (Dye)", "#ca1f7b", SourceOther, nil},
+	{"magenta (process)", "Magenta (Process)", "#ff0090", SourceOther, nil},
+	{"magenta haze", "Magenta Haze", "#9f4576", SourceOther, nil},
+	{"magic mint", "Magic Mint", "#aaf0d1", SourceCrayola, nil},
+	{"magnolia", "Magnolia", "#f8f4ff", SourceOther, nil},
+	{"mahogany", "Mahogany", "#c04000", SourceOther, nil},
+	{"maize", "Maize", "#fbec5d", SourceOther, nil},
+	{"majorelle blue", "Majorelle Blue", "#6050dc", SourceOther, nil},
+	{"malachite", "Malachite", "#0bda51", SourceOther, nil},
+	{"manatee", "Manatee", "#979aaa", SourceCrayola, nil},
+	{"mango mojito", "Mango Mojito", "#d69c2f", SourceOther, nil},
+	{"mango orange", "Mango Orange", "#ff8040", SourceOther, nil},
+	{"mango tango", "Mango Tango", "#ff8243", SourceCrayola, nil},
+	{"mantis", "Mantis", "#74c365", SourceOther, nil},
+	{"marble blue", "Marble Blue", "#566d7e", SourceOther, nil},
+	{"mardi gras", "Mardi Gras", "#880085", SourceOther, nil},
+	{"margarita", "Margarita", "#b0c24a", SourceOther, nil},
+	{"marigold", "Marigold", "#fdac53", SourceOther, nil},
+	{"marina", "Marina", "#4f84c4", SourceOther, nil},
+	{"maroon", "Maroon", "#800000", SourceCSSBasic, nil},
+	{"maroon (computer hope)", "Maroon (Computer Hope)", "#810541", SourceOther, []string{"maroon1"}},
+	{"marsala", "Marsala", "#955251", SourcePantone, nil},
+	{"martini olive", "Martini Olive", "#766f57", SourceOther, nil},
+	{"mauve", "Mauve", "#e0b0ff", SourceOther, nil},
+	{"mauvelous", "Mauvelous", "#ef98aa", SourceCrayola, nil},
+	{"maximum green yellow", "Maximum Green Yellow", "#d9e650", SourceOther, nil},
+	{"maya blue", "Maya Blue", "#73c2fb", SourceOther, nil},
+	{"meadowlark", "Meadowlark", "#ecdb54", SourceOther, nil},
+	{"meat brown", "Meat Brown", "#e5b73b", SourceOther, nil},
+	{"medium aquamarine", "Medium Aquamarine", "#66cdaa", SourceCSS, []string{"mediumaquamarine"}},
+	{"medium aquamarine (computer hope)", "Medium Aquamarine (Computer Hope)", "#348781", SourceOther, []string{"medium aquamarine1"}},
+	{"medium blue", "Medium Blue", "#0000cd", SourceCSS, []string{"mediumblue"}},
+	{"medium candy apple red", "Medium Candy Apple Red", "#e2062c", SourceOther, nil},
+	{"medium electric blue", "Medium Electric Blue", "#035096", SourceOther, nil},
+	{"medium forest green", "Medium Forest Green", "#347235", SourceOther, nil},
+	{"medium jungle green", "Medium Jungle Green", "#1c352d", SourceOther, nil},
+	{"medium orchid", "Medium Orchid", "#ba55d3", SourceCSS, []string{"mediumorchid"}},
+	{"medium orchid (computer hope)", "Medium Orchid (Computer Hope)", "#b048b5", SourceOther, []string{"medium orchid1"}},
+	{"medium purple", "Medium Purple", "#9370db", SourceCSS, []string{"mediumpurple"}},
+	{"medium purple (computer hope)", "Medium Purple (Computer Hope)", "#8467d7", SourceOther, []string{"medium purple1"}},
+	{"medium red violet", "Medium Red Violet", "#bb3385", SourceOther, nil},
+	{"medium ruby", "Medium Ruby", "#aa4069", SourceOther, nil},
+	{"medium sea green", "Medium Sea Green", "#3cb371", SourceCSS, []string{"mediumseagreen"}},
+	{"medium sea green (computer hope)", "Medium Sea Green (Computer Hope)", "#306754", SourceOther, nil},
+	{"medium sky blue", "Medium Sky Blue", "#80daeb", SourceOther, nil},
+	{"medium slate blue", "Medium Slate Blue", "#7b68ee", SourceCSS, []string{"mediumslateblue"}},
+	{"medium spring bud", "Medium Spring Bud", "#c9dc87", SourceOther, nil},
+	{"medium spring green", "Medium Spring Green", "#00fa9a", SourceCSS, []string{"mediumspringgreen"}},
+	{"medium spring green (computer hope)", "Medium Spring Green (Computer Hope)", "#348017", SourceOther, nil},
+	{"medium taupe", "Medium Taupe", "#674c47", SourceOther, nil},
+	{"medium turquoise", "Medium Turquoise", "#48d1cc", SourceCSS, []string{"mediumturquoise"}},
+	{"medium turquoise (computer hope)", "Medium Turquoise (Computer Hope)", "#48cccd", SourceOther, []string{"medium turquoise1"}},
+	{"medium tuscan red", "Medium Tuscan Red", "#79443b", SourceOther, nil},
+	{"medium vermilion", "Medium Vermilion", "#d9603b", SourceOther, nil},
+	{"medium violet red", "Medium Violet Red", "#c71585", SourceCSS, []string{"mediumvioletred"}},
+	{"medium violet red (computer hope)", "Medium Violet Red (Computer Hope)", "#ca226b", SourceOther, []string{"medium violet red1"}},
+	{"meerkat", "Meerkat", "#a9754f", SourceOther, nil},
+	{"mellow apricot", "Mellow Apricot", "#f8b878", SourceOther, nil},
+	{"mellow yellow", "Mellow Yellow", "#f8de7e", SourceOther, nil},
+	{"melon", "Melon", "#fdbcb4", SourceOther, nil},
+	{"metallic seaweed", "Metallic Seaweed", "#0a7e8c", SourceOther, nil},
+	{"metallic silver", "Metallic Silver", "#bcc6cc", SourceOther, nil},
+	{"metallic sunburst", "Metallic Sunburst", "#9c7c38", SourceOther, nil},
+	{"mexican pink", "Mexican Pink", "#e4007c", SourceOther, nil},
+	{"middle green yellow", "Middle Green Yellow", "#acbf60", SourceOther, nil},
+	{"midnight", "Midnight", "#2b1b17", SourceOther, nil},
+	{"midnight blue", "Midnight Blue", "#191970", SourceCSS, []string{"midnightblue"}},
+	{"midnight blue (computer hope)", "Midnight Blue (Computer Hope)", "#151b54", SourceOther, []string{"midnight blue1"}},
+	{"midnight green", "Midnight Green", "#004953", SourceOther, nil},
+	{"mikado yellow", "Mikado Yellow", "#ffc40c", SourceOther, nil},
+	{"milk", "Milk", "#fdfff5", SourceOther, nil},
+	{"milk white", "Milk White", "#fefcff", SourceOther, nil},
+	{"mimosa", "Mimosa", "#f0bf59", SourcePantone, nil},
+	{"mindaro", "Mindaro", "#e3f988", SourceOther, nil},
+	{"mint", "Mint", "#3eb489", SourceOther, nil},
+	{"mint (pantone)", "Mint (Pantone)", "#00a170", SourceOther, []string{"mint1"}},
+	{"mint cream", "Mint Cream", "#f5fffa", SourceCSS, []string{"mintcream"}},
+	{"mint green", "Mint Green", "#98ff98", SourceOther, nil},
+	{"mist blue", "Mist Blue", "#646d7e", SourceOther, nil},
+	{"misty rose", "Misty Rose", "#ffe4e1", SourceCSS, []string{"mistyrose"}},
+	{"misty rose (computer hope)", "Misty Rose (Computer Hope)", "#fbbbb9", SourceOther, []string{"misty rose1"}},
+	{"moccasin", "Moccasin", "#ffe4b5", SourceCSS, []string{"moccasin1"}},
+	{"moccasin (computer hope)", "Moccasin (Computer Hope)", "#827839", SourceOther, []string{"moccasin2"}},
+	{"mocha", "Mocha", "#493d26", SourceOther, nil},
+	{"moonstone blue", "Moonstone Blue", "#73a9c2", SourceOther, nil},
+	{"mordant red 19", "Mordant Red 19", "#ae0c00", SourceOther, nil},
+	{"moss green", "Moss Green", "#8a9a5b", SourceOther, nil},
+	{"mountain meadow", "Mountain Meadow", "#30ba8f", SourceCrayola, nil},
+	{"mountbatten pink", "Mountbatten Pink", "#997a8d", SourceOther, nil},
+	{"msu green", "Msu Green", "#18453b", SourceOther, nil},
+	{"mughal green", "Mughal Green", "#306030", SourceOther, nil},
+	{"mulberry", "Mulberry", "#c54b8c", SourceOther, nil},
+	{"mustard", "Mustard", "#ffdb58", SourceOther, nil},
+	{"myrtle", "Myrtle", "#21421e", SourceOther, nil},
+	{"myrtle green", "Myrtle Green", "#317873", SourceOther, nil},
+	{"nadeshiko pink", "Nadeshiko Pink", "#f6adc6", SourceOther, nil},
+	{"napier green", "Napier Green", "#2a8000", SourceOther, nil},
+	{"navajo white", "Navajo White", "#ffdead", SourceCSS, []string{"navajowhite"}},
+	{"navy", "Navy", "#000080", SourceCSSBasic, nil},
+	{"navy blue", "Navy Blue", "#000080", SourceOther, nil},
+	{"navy peony", "Navy Peony", "#223a5e", SourceOther, nil},
+	{"navy purple", "Navy Purple", "#9457eb", SourceOther, nil},
+	{"nebula green", "Nebula Green", "#59e817", SourceOther, nil},
+	{"nebulas blue", "Nebulas Blue", "#3f69aa", SourceOther, nil},
+	{"neon carrot", "Neon Carrot", "#ffa343", SourceCrayola, nil},
+	{"neon fuchsia", "Neon Fuchsia", "#fe4164", SourceOther, nil},
+	{"neon green", "Neon Green", "#39ff14", SourceOther, nil},
+	{"neon pink", "Neon Pink", "#f535aa", SourceOther, nil},
+	{"neutral gray", "Neutral Gray", "#898e8c", SourceOther, nil},
+	{"new car", "New Car", "#214fc6", SourceOther, nil},
+	{"new york pink", "New York Pink", "#d7837f", SourceOther, nil},
+	{"niagara", "Niagara", "#578ca9", SourceOther, nil},
+	{"night", "Night", "#0c090a", SourceOther, nil},
+	{"non photo blue", "Non Photo Blue", "#a4dded", SourceOther, nil},
+	{"north texas green", "North Texas Green", "#059033", SourceOther, nil},
+	{"northern lights blue", "Northern Lights Blue", "#78c7c7", SourceOther, nil},
+	{"nyanza", "Nyanza", "#e9ffdb", SourceOther, nil},
+	{"oak brown", "Oak Brown", "#806517", SourceOther, nil},
+	{"ocean blue", "Ocean Blue", "#2b65ec", SourceOther, nil},
+	{"ocean boat blue", "Ocean Boat Blue", "#0077be", SourceOther, nil},
+	{"ochre", "Ochre", "#cc7722", SourceOther, nil},
+	{"office green", "Office Green", "#008000", SourceOther, nil},
+	{"oil", "Oil", "#3b3131", SourceOther, nil},
+	{"old bamboo color", "Old Bamboo Color", "#5e644f", SourceOther, nil},
+	{"old gold", "Old Gold", "#cfb53b", SourceOther, nil},
+	{"old heliotrope", "Old Heliotrope", "#563c5c", SourceOther, nil},
+	{"old lace", "Old Lace", "#fdf5e6", SourceCSS, []string{"oldlace"}},
+	{"old lavender", "Old Lavender", "#796878", SourceOther, nil},
+	{"old moss green", "Old Moss Green", "#867e36", SourceOther, nil},
+	{"old rose", "Old Rose", "#c08081", SourceOther, nil},
+	{"old silver", "Old Silver", "#848482", SourceOther, nil},
+	{"olive", "Olive", "#808000", SourceCSSBasic, nil},
+	{"olive drab", "Olive Drab", "#6b8e23", SourceCSS, []string{"olivedrab"}},
+	{"olive drab #7", "Olive Drab #7", "#3c341f", SourceOther, nil},
+	{"olivine", "Olivine", "#9ab973", SourceOther, nil},
+	{"onyx", "Onyx", "#353839", SourceOther, nil},
+	{"opera mauve", "Opera Mauve", "#b784a7", SourceOther, nil},
+	{"orange", "Orange", "#ffa500", SourceCSS, nil},
+	{"orange (color wheel)", "Orange (Color Wheel)", "#ff7f00", SourceOther, nil},
+	{"orange (ryb)", "Orange (RYB)", "#fb9902", SourceRYB, nil},
+	{"orange gold", "Orange Gold", "#d4a017", SourceOther, nil},
+	{"orange peel", "Orange Peel", "#ff9f00", SourceOther, nil},
+	{"orange red", "Orange Red", "#ff4500", SourceCSS, []string{"orangered"}},
+	{"orange salmon", "Orange Salmon", "#c47451", SourceOther, nil},
+	{"orange tiger", "Orange Tiger", "#f96714", SourceOther, nil},
+	{"orchid", "Orchid", "#da70d6", SourceCSS, nil},
+	{"orchid pink", "Orchid Pink", "#f28dcd", SourceOther, nil},
+	{"orioles orange", "Orioles Orange", "#fb4f14", SourceOther, nil},
+	{"otter brown", "Otter Brown", "#654321", SourceOther, nil},
+	{"outer space", "Outer Space", "#414a4c", SourceCrayola, nil},
+	{"outrageous orange", "Outrageous Orange", "#ff6e4a", SourceCrayola, nil},
+	{"oxford blue", "Oxford Blue", "#002147", SourceOther, nil},
+	{"pakistan green", "Pakistan Green", "#000060", SourceOther, nil},
+	{"pakistan green (light)", "Pakistan Green (Light)", "#006600", SourceOther, []string{"pakistan green1"}},
+	{"palatinate blue", "Palatinate Blue", "#273be2", SourceOther, nil},
+	{"palatinate purple", "Palatinate Purple", "#682860", SourceOther, nil},
+	{"pale aqua", "Pale Aqua", "#bcd4e6", SourceOther, nil},
+	{"pale blue lily", "Pale Blue Lily", "#cfecec", SourceOther, nil},
+	{"pale brown", "Pale Brown", "#987654", SourceOther, nil},
+	{"pale carmine", "Pale Carmine", "#af4035", SourceOther, nil},
+	{"pale cerulean", "Pale Cerulean", "#9bc4e2", SourceOther, nil},
+	{"pale chestnut", "Pale Chestnut", "#ddadaf", SourceOther, nil},
+	{"pale copper", "Pale Copper", "#da8a67", SourceOther, nil},
+	{"pale cornflower blue", "Pale Cornflower Blue", "#abcdef", SourceOther, nil},
+	{"pale dogwood", "Pale Dogwood", "#edcdc2", SourceOther, nil},
+	{"pale gold", "Pale Gold", "#e6be8a", SourceOther, nil},
+	{"pale goldenrod", "Pale Goldenrod", "#eee8aa", SourceCSS, []string{"palegoldenrod"}},
+	{"pale green", "Pale Green", "#98fb98", SourceCSS, []string{"palegreen"}},
+	{"pale lavender", "Pale Lavender", "#dcd0ff", SourceOther, nil},
+	{"pale magenta", "Pale Magenta", "#f984e5", SourceOther, nil},
+	{"pale pink", "Pale Pink", "#fadadd", SourceOther, nil},
+	{"pale robin egg blue", "Pale Robin Egg Blue", "#96ded1", SourceOther, nil},
+	{"pale silver", "Pale Silver", "#c9c0bb", SourceOther, nil},
+	{"pale spring bud", "Pale Spring Bud", "#ecebbd", SourceOther, nil},
+	{"pale taupe", "Pale Taupe", "#bc987e", SourceOther, nil},
+	{"pale turquoise", "Pale Turquoise", "#afeeee", SourceCSS, []string{"paleturquoise"}},
+	{"pale violet red", "Pale Violet Red", "#db7093", SourceCSS, []string{"palevioletred"}},
+	{"pale violet red (computer hope)", "Pale Violet Red (Computer Hope)", "#d16587", SourceOther, []string{"pale violet red1"}},
+	{"paloma", "Paloma", "#9f9c99", SourceOther, nil},
+	{"pansy purple", "Pansy Purple", "#78184a", SourceOther, nil},
+	{"pantone turquoise", "Pantone Turquoise", "#41b6ab", SourceOther, nil},
+	{"paolo veronese green", "Paolo Veronese Green", "#009b7d", SourceOther, nil},
+	{"papaya orange", "Papaya Orange", "#e56717", SourceOther, nil},
+	{"papaya whip", "Papaya Whip", "#ffefd5", SourceCSS, []string{"papayawhip"}},
+	{"paradise pink", "Paradise Pink", "#e63e62", SourceOther, nil},
+	{"parchment", "Parchment", "#ffffc2", SourceOther, nil},
+	{"paris green", "Paris Green", "#50c878", SourceOther, nil},
+	{"pastel blue", "Pastel Blue", "#aec6cf", SourceOther, nil},
+	{"pastel blue (computer hope)", "Pastel Blue (Computer Hope)", "#b4cfec", SourceOther, []string{"pastel blue1"}},
+	{"pastel brown", "Pastel Brown", "#836953", SourceOther, nil},
+	{"pastel gray", "Pastel Gray", "#cfcfc4", SourceOther, nil},
+	{"pastel green", "Pastel Green", "#77dd77", SourceOther, nil},
+	{"pastel magenta", "Pastel Magenta", "#f49ac2", SourceOther, nil},
+	{"pastel orange", "Pastel Orange", "#ffb347", SourceOther, nil},
+	{"pastel pink", "Pastel Pink", "#dea5a4", SourceOther, nil},
+	{"pastel purple", "Pastel Purple", "#b39eb5", SourceOther, nil},
+	{"pastel red", "Pastel Red", "#ff6961", SourceOther, nil},
+	{"pastel violet", "Pastel Violet", "#cb99c9", SourceOther, nil},
+	{"pastel yellow", "Pastel Yellow", "#fdfd96", SourceOther, nil},
+	{"payne grey", "Payne Grey", "#536878", SourceOther, nil},
+	{"peach", "Peach", "#ffe5b4", SourceOther, nil},
+	{"peach echo", "Peach Echo", "#f7786b", SourceOther, nil},
+	{"peach fuzz", "Peach Fuzz", "#f87c56", SourceOther, nil},
+	{"peach orange", "Peach Orange", "#ffcc99", SourceOther, nil},
+	{"peach pink", "Peach Pink", "#fa9a85", SourceOther, nil},
+	{"peach puff", "Peach Puff", "#ffdab9", SourceCSS, []string{"peachpuff"}},
+	{"peach yellow", "Peach Yellow", "#fadfad", SourceOther, nil},
+	{"pear", "Pear", "#d1e231", SourceOther, nil},
+	{"pearl", "Pearl", "#fdeef4", SourceOther, nil},
+	{"pearl aqua", "Pearl Aqua", "#88d8c0", SourceOther, nil},
+	{"pearly purple", "Pearly Purple", "#b768a2", SourceOther, nil},
+	{"pepper stem", "Pepper Stem", "#8d9440", SourceOther, nil},
+	{"peridot", "Peridot", "#e6e200", SourceOther, nil},
+	{"periwinkle", "Periwinkle", "#ccccff", SourceOther, nil},
+	{"periwinkle (computer hope)", "Periwinkle (Computer Hope)", "#e9cfec", SourceOther, []string{"periwinkle1"}},
+	{"persian blue", "Persian Blue", "#1c39bb", SourceOther, nil},
+	{"persian green", "Persian Green", "#00a693", SourceOther, nil},
+	{"persian indigo", "Persian Indigo", "#32127a", SourceOther, nil},
+	{"persian orange", "Persian Orange", "#d99058", SourceOther, nil},
+	{"persian pink", "Persian Pink", "#f77fbe", SourceOther, nil},
+	{"persian red", "Persian Red", "#cc3333", SourceOther, nil},
+	{"persian rose", "Persian Rose", "#fe28a2", SourceOther, nil},
+	{"persimmon", "Persimmon", "#ec5800", SourceOther, nil},
+	{"peru", "Peru", "#cd853f", SourceCSS, nil},
+	{"phthalo blue", "Phthalo Blue", "#000f89", SourceOther, nil},
+	{"phthalo green", "Phthalo Green", "#123524", SourceOther, nil},
+	{"picton blue", "Picton Blue", "#45b1e8", SourceOther, nil},
+	{"pictorial carmine", "Pictorial Carmine", "#c30b4e", SourceOther, nil},
+	{"pig pink", "Pig Pink", "#fdd7e4", SourceOther, nil},
+	{"piggy pink", "Piggy Pink", "#fddde6", SourceCrayola, nil},
+	{"pine green", "Pine Green", "#01796f", SourceCrayola, nil},
+	{"pine green (computer hope)", "Pine Green (Computer Hope)", "#387c44", SourceOther, []string{"pine green1"}},
+	{"pine needle color", "Pine Needle Color", "#454d32", SourceOther, nil},
+	{"pink", "Pink", "#ffc0cb", SourceCSS, nil},
+	{"pink (computer hope)", "Pink (Computer Hope)", "#faafbe", SourceOther, []string{"pink1"}},
+	{"pink bow", "Pink Bow", "#c48189", SourceOther, nil},
+	{"pink bubblegum", "Pink Bubblegum", "#ffdfdd", SourceOther, nil},
+	{"pink cupcake", "Pink Cupcake", "#e45e9d", SourceOther, nil},
+	{"pink daisy", "Pink Daisy", "#e799a3", SourceOther, nil},
+	{"pink lace", "Pink Lace", "#ffddf4", SourceOther, nil},
+	{"pink lavender", "Pink Lavender", "#d8b2d1", SourceOther, nil},
+	{"pink lavender (dark)", "Pink Lavender (Dark)", "#dbb1cd", SourceOther, []string{"pink lavender1"}},
+	{"pink lemonade", "Pink Lemonade", "#e4287c", SourceOther, nil},
+	{"pink peacock", "Pink Peacock", "#c62168", SourceOther, nil},
+	{"pink pearl", "Pink Pearl", "#e7accf", SourceOther, nil},
+	{"pink rose", "Pink Rose", "#e7a1b0", SourceOther, nil},
+	{"pink sherbet", "Pink Sherbet", "#f78fa7", SourceOther, nil},
+	{"pink yarrow", "Pink Yarrow", "#ce3175", SourceOther, nil},
+	{"pistachio", "Pistachio", "#93c572", SourceOther, nil},
+	{"pistachio green", "Pistachio Green", "#9dc209", SourceOther, nil},
+	{"platinum", "Platinum", "#e5e4e2", SourceOther, nil},
+	{"plum", "Plum", "#dda0dd", SourceCSS, nil},
+	{"plum (computer hope)", "Plum (Computer Hope)", "#b93b8f", SourceOther, []string{"plum1"}},
+	{"plum (traditional)", "Plum (Traditional)", "#8e4585", SourceOther, nil},
+	{"plum pie", "Plum Pie", "#7d0541", SourceOther, nil},
+	{"plum purple", "Plum Purple", "#583759", SourceOther, nil},
+	{"plum velvet", "Plum Velvet", "#7d0552", SourceOther, nil},
+	{"pomelo", "Pomelo", "#96a53c", SourceOther, nil},
+	{"pomelo white", "Pomelo White", "#f9ffe3", SourceOther, nil},
+	{"pomp and power", "Pomp and Power", "#86608e", SourceOther, nil},
+	{"popstar", "Popstar", "#be4f62", SourceOther, nil},
+	{"portland orange", "Portland Orange", "#ff5a36", SourceOther, nil},
+	{"powder blue", "Powder Blue", "#b0e0e6", SourceCSS, []string{"powderblue"}},
+	{"powder blue (computer hope)", "Powder Blue (Computer Hope)", "#c6deff", SourceOther, []string{"powder blue1"}},
+	{"primrose yellow", "Primrose Yellow", "#f6d155", SourceOther, nil},
+	{"princess blue", "Princess Blue", "#00539c", SourceOther, nil},
+	{"princeton orange", "Princeton Orange", "#ff8f00", SourceOther, nil},
+	{"prune", "Prune", "#701c1c", SourceOther, nil},
+	{"prussian blue", "Prussian Blue", "#003153", SourceOther, nil},
+	{"psychedelic purple", "Psychedelic Purple", "#df00ff", SourceOther, nil},
+	{"puce", "Puce", "#cc8899", SourceOther, nil},
+	{"puce (computer hope)", "Puce (Computer Hope)", "#7f5a58", SourceOther, []string{"puce1"}},
+	{"pullman brown", "Pullman Brown", "#644117", SourceOther, nil},
+	{"pumpkin", "Pumpkin", "#ff7518", SourceOther, nil},
+	{"pumpkin orange", "Pumpkin Orange", "#f87217", SourceOther, nil},
+	{"purple", "Purple", "#800080", SourceCSSBasic, nil},
+	{"purple (computer hope)", "Purple (Computer Hope)", "#8e35ef", SourceOther, []string{"purple1"}},
+	{"purple (munsell)", "Purple (Munsell)", "#9f00c5", SourceMunsell, nil},
+	{"purple amethyst", "Purple Amethyst", "#6c2dc7", SourceOther, nil},
+	{"purple daffodil", "Purple Daffodil", "#b041ff", SourceOther, nil},
+	{"purple dragon", "Purple Dragon", "#c38ec7", SourceOther, nil},
+	{"purple flower", "Purple Flower", "#a74ac7", SourceOther, nil},
+	{"purple haze", "Purple Haze", "#4e387e", SourceOther, nil},
+	{"purple heart", "Purple Heart", "#69359c", SourceOther, nil},
+	{"purple iris", "Purple Iris", "#571b7e", SourceOther, nil},
+	{"purple jam", "Purple Jam", "#6a287e", SourceOther, nil},
+	{"purple mimosa", "Purple Mimosa", "#9e7bff", SourceOther, nil},
+	{"purple monster", "Purple Monster", "#461b7e", SourceOther, nil},
+	{"purple mountain majesty", "Purple Mountain Majesty", "#9678b6", SourceCrayola, nil},
+	{"purple pizzazz", "Purple Pizzazz", "#fe4eda", SourceCrayola, nil},
+	{"purple sage bush", "Purple Sage Bush", "#7a5dc7", SourceOther, nil},
+	{"purple taupe", "Purple Taupe", "#50404d", SourceOther, nil},
+	{"purpureus", "Purpureus", "#9a4eae", SourceOther, nil},
+	{"quartz", "Quartz", "#51484f", SourceOther, nil},
+	{"queen blue", "Queen Blue", "#436b95", SourceOther, nil},
+	{"queen pink", "Queen Pink", "#e8ccd7", SourceOther, nil},
+	{"quetzal green", "Quetzal Green", "#006e6d", SourceOther, nil},
+	{"quiet gray", "Quiet Gray", "#bcbcbe", SourceOther, nil},
+	{"quinacridone magenta", "Quinacridone Magenta", "#8e3a59", SourceOther, nil},
+	{"rackley", "Rackley", "#5d8aa8", SourceOther, nil},
+	{"radiant orchid", "Radiant Orchid", "#b565a7", SourcePantone, nil},
+	{"radical red", "Radical Red", "#ff355e", SourceCrayola, nil},
+	{"rajah", "Rajah", "#fbab60", SourceOther, nil},
+	{"raspberry", "Raspberry", "#e30b5d", SourceOther, nil},
+	{"raspberry glace", "Raspberry Glace", "#915f6d", SourceOther, nil},
+	{"raspberry pink", "Raspberry Pink", "#e25098", SourceOther, nil},
+	{"raspberry rose", "Raspberry Rose", "#b3446c", SourceOther, nil},
+	{"raspberry sorbet", "Raspberry Sorbet", "#d2386c", SourceOther, nil},
+	{"raw umber", "Raw Umber", "#826644", SourceOther, nil},
+	{"razzle dazzle rose", "Razzle Dazzle Rose", "#ff33cc", SourceOther, nil},
+	{"razzmatazz", "Razzmatazz", "#e3256b", SourceCrayola, nil},
+	{"razzmic berry", "Razzmic Berry", "#8d4e85", SourceOther, nil},
+	{"rebecca purple", "Rebecca Purple", "#663399", SourceCSS, []string{"rebeccapurple"}},
+	{"red", "Red", "#ff0000", SourceCSSBasic, nil},
+	{"red (munsell)", "Red (Munsell)", "#f2003c", SourceMunsell, nil},
+	{"red (ncs)", "Red (NCS)", "#c40233", SourceNCS, nil},
+	{"red (pigment)", "Red (Pigment)", "#ed1c24", SourceOther, nil},
+	{"red (ryb)", "Red (RYB)", "#fe2712", SourceRYB, nil},
+	{"red brown", "Red Brown", "#a52a2a", SourceOther, nil},
+	{"red devil", "Red Devil", "#860111", SourceOther, nil},
+	{"red dirt", "Red Dirt", "#7f5217", SourceOther, nil},
+	{"red fox", "Red Fox", "#c35817", SourceOther, nil},
+	{"red orange", "Red Orange", "#ff5349", SourceOther, nil},
+	{"red pear", "Red Pear", "#7f4145", SourceOther, nil},
+	{"red purple", "Red Purple", "#e40078", SourceOther, nil},
+	{"red violet", "Red Violet", "#c71585", SourceOther, nil},
+	{"red wine", "Red Wine", "#990012", SourceOther, nil},
+	{"redwood", "Redwood", "#a45a52", SourceOther, nil},
+	{"regalia", "Regalia", "#522d80", SourceOther, nil},
+	{"resolution blue", "Resolution Blue", "#002387", SourceOther, nil},
+	{"rhythm", "Rhythm", "#777696", SourceOther, nil},
+	{"rich black", "Rich Black", "#004040", SourceOther, nil},
+	{"rich brilliant lavender", "Rich Brilliant Lavender", "#f1a7fe", SourceOther, nil},
+	{"rich carmine", "Rich Carmine", "#d70040", SourceOther, nil},
+	{"rich electric blue", "Rich Electric Blue", "#0892d0", SourceOther, nil},
+	{"rich lavender", "Rich Lavender", "#a76bcf", SourceOther, nil},
+	{"rich lilac", "Rich Lilac", "#b666d2", SourceOther, nil},
+	{"rich maroon", "Rich Maroon", "#b03060", SourceOther, nil},
+	{"rifle green", "Rifle Green", "#444c38", SourceOther, nil},
+	{"roast coffee", "Roast Coffee", "#704241", SourceOther, nil},
+	{"robin egg blue", "Robin Egg Blue", "#00cccc", SourceOther, nil},
+	{"robin egg blue (computer hope)", "Robin Egg Blue (Computer Hope)", "#bdedff", SourceOther, []string{"robin egg blue1"}},
+	{"rocket metallic", "Rocket Metallic", "#8a7f80", SourceOther, nil},
+	{"rocky road", "Rocky Road", "#5a3e36", SourceOther, nil},
+	{"rogue pink", "Rogue Pink", "#c12869", SourceOther, nil},
+	{"roman silver", "Roman Silver", "#838996", SourceOther, nil},
+	{"rose", "Rose", "#ff007f", SourceOther, nil},
+	{"rose (computer hope)", "Rose (Computer Hope)", "#e8adaa", SourceOther, []string{"rose1"}},
+	{"rose bonbon", "Rose Bonbon", "#f9429e", SourceOther, nil},
+	{"rose ebony", "Rose Ebony", "#674846", SourceOther, nil},
+	{"rose gold", "Rose Gold", "#b76e79", SourceOther, nil},
+	{"rose gold (computer hope)", "Rose Gold (Computer Hope)", "#ecc5c0", SourceOther, []string{"rose gold1"}},
+	{"rose madder", "Rose Madder", "#e32636", SourceOther, nil},
+	{"rose pink", "Rose Pink", "#ff66cc", SourceOther, nil},
+	{"rose quartz", "Rose Quartz", "#aa98a9", SourceOther, nil},
+	{"rose quartz (pantone)", "Rose Quartz (Pantone)", "#f7cac9", SourcePantone, []string{"rose quartz1"}},
+	{"rose red", "Rose Red", "#c21e56", SourceOther, nil},
+	{"rose taupe", "Rose Taupe", "#905d5d", SourceOther, nil},
+	{"rose vale", "Rose Vale", "#ab4e52", SourceOther, nil},
+	{"rosewood", "Rosewood", "#65000b", SourceOther, nil},
+	{"rosso corsa", "Rosso Corsa", "#d40000", SourceOther, nil},
+	{"rosy brown", "Rosy Brown", "#bc8f8f", SourceCSS, []string{"rosybrown"}},
+	{"rosy brown (computer hope)", "Rosy Brown (Computer Hope)", "#b38481", SourceOther, []string{"rosy brown1"}},
+	{"rosy finch", "Rosy Finch", "#7f4e52", SourceOther, nil},
+	{"royal azure", "Royal Azure", "#0038a8", SourceOther, nil},
+	{"royal blue", "Royal Blue", "#4169e1", SourceCSS, []string{"royalblue", "royal blue1"}},
+	{"royal blue (computer hope)", "Royal Blue (Computer Hope)", "#2b60de", SourceOther, nil},
+	{"royal blue (traditional)", "Royal Blue (Traditional)", "#002366", SourceOther, nil},
+	{"royal blue (web)", "Royal Blue (Web)", "#041690", SourceOther, nil},
+	{"royal fuchsia", "Royal Fuchsia", "#ca2c92", SourceOther, nil},
+	{"royal purple", "Royal Purple", "#7851a9", SourceOther, nil},
+	{"rubber ducky yellow", "Rubber Ducky Yellow", "#ffd801", SourceOther, nil},
+	{"ruber", "Ruber", "#ce4676", SourceOther, nil},
+	{"rubine red", "Rubine Red", "#d10056", SourceOther, nil},
+	{"ruby", "Ruby", "#e0115f", SourceOther, nil},
+	{"ruby red", "Ruby Red", "#9b111e", SourceOther, nil},
+	{"ruddy", "Ruddy", "#ff0028", SourceOther, nil},
+	{"ruddy brown", "Ruddy Brown", "#bb6528", SourceOther, nil},
+	{"ruddy pink", "Ruddy Pink", "#e18e96", SourceOther, nil},
+	{"rufous", "Rufous", "#a81c07", SourceOther, nil},
+	{"russet", "Russet", "#80461b", SourceOther, nil},
+	{"russet orange", "Russet Orange", "#e47a2e", SourceOther, nil},
+	{"russian green", "Russian Green", "#679267", SourceOther, nil},
+	{"russian violet", "Russian Violet", "#32174d", SourceOther, nil},
+	{"rust", "Rust", "#b7410e", SourceOther, nil},
+	{"rust (computer hope)", "Rust (Computer Hope)", "#c36241", SourceOther, []string{"rust1"}},
+	{"rusty celadon", "Rusty Celadon", "#898a74", SourceOther, nil},
+	{"rusty red", "Rusty Red", "#da2c43", SourceOther, nil},
+	{"sacramento state green", "Sacramento State Green", "#00563f", SourceOther, nil},
+	{"saddle brown", "Saddle Brown", "#8b4513", SourceCSS, []string{"saddlebrown"}},
+	{"safety orange", "Safety Orange", "#ff6700", SourceOther, nil},
+	{"safety yellow", "Safety Yellow", "#eed202", SourceOther, nil},
+	{"saffron", "Saffron", "#fbb917", SourceOther, nil},
+	{"sage", "Sage", "#bcb88a", SourceOther, nil},
+	{"sage green", "Sage Green", "#848b79", SourceOther, nil},
+	{"sailor blue", "Sailor Blue", "#2e4a62", SourceOther, nil},
+	{"salad green", "Salad Green", "#a1c935", SourceOther, nil},
+	{"salmon", "Salmon", "#fa8072", SourceCSS, nil},
+	{"salmon (light)", "Salmon (Light)", "#ff8c69", SourceOther, []string{"salmon1"}},
+	{"salmon pink", "Salmon Pink", "#ff91a4", SourceOther, nil},
+	{"sand", "Sand", "#c2b280", SourceOther, nil},
+	{"sand dollar", "Sand Dollar", "#decdbf", SourcePantone, nil},
+	{"sandstone", "Sandstone", "#786d5f", SourceOther, nil},
+	{"sandstorm", "Sandstorm", "#ecd540", SourceOther, nil},
+	{"sandy brown", "Sandy Brown", "#f4a460", SourceCSS, []string{"sandybrown"}},
+	{"sandy brown (computer hope)", "Sandy Brown (Computer Hope)", "#ee9a4d", SourceOther, []string{"sandy brown1"}},
+	{"sandy taupe", "Sandy Taupe", "#967117", SourceOther, nil},
+	{"sangria", "Sangria", "#92000a", SourceOther, nil},
+	{"sangria (computer hope)", "Sangria (Computer Hope)", "#7e3817", SourceOther, []string{"sangria1"}},
+	{"sap green", "Sap Green", "#507d2a", SourceOther, nil},
+	{"sapphire", "Sapphire", "#0f52ba", SourceOther, nil},
+	{"sapphire blue", "Sapphire Blue", "#0067a5", SourceOther, nil},
+	{"sapphire blue (computer hope)", "Sapphire Blue (Computer Hope)", "#2554c7", SourceOther, []string{"sapphire blue1"}},
+	{"sargasso sea", "Sargasso Sea", "#485167", SourceOther, nil},
+	{"satin sheen gold", "Satin Sheen Gold", "#cba135", SourceOther, nil},
+	{"scarlet", "Scarlet", "#ff2400", SourceOther, nil},
+	{"schauss pink", "Schauss Pink", "#ff91af", SourceOther, nil},
+	{"school bus yellow", "School Bus Yellow", "#ffd800", SourceOther, nil},
+	{"screamin green", "Screamin Green", "#76ff7a", SourceOther, nil},
+	{"sea blue", "Sea Blue", "#006994", SourceOther, nil},
+	{"sea blue (computer hope)", "Sea Blue (Computer Hope)", "#c2dfff", SourceOther, []string{"sea blue1"}},
+	{"sea green", "Sea Green", "#2e8b57", SourceCSS, []string{"seagreen"}},
+	{"sea green (computer hope)", "Sea Green (Computer Hope)", "#4e8975", SourceOther, nil},
+	{"sea shell", "Sea Shell", "#fff5ee", SourceCSS, []string{"seashell"}},
+	{"sea turtle green", "Sea Turtle Green", "#438d80", SourceOther, nil},
+	{"seal brown", "Seal Brown", "#321414", SourceOther, nil},
+	{"seaweed green", "Seaweed Green", "#437c17", SourceOther, nil},
+	{"sedona", "Sedona", "#cc6600", SourceOther, nil},
+	{"selective yellow", "Selective Yellow", "#ffba00", SourceOther, nil},
+	{"sepia", "Sepia", "#704214", SourceOther, nil},
+	{"sepia (computer hope)", "Sepia (Computer Hope)", "#7f462c", SourceOther, []string{"sepia1"}},
+	{"serenity", "Serenity", "#92a8d1", SourcePantone, nil},
+	{"shaded spruce", "Shaded Spruce", "#005960", SourceOther, nil},
+	{"shadow", "Shadow", "#8a795d", SourceOther, nil},
+	{"shadow blue", "Shadow Blue", "#778ba5", SourceOther, nil},
+	{"shampoo", "Shampoo", "#ffcff1", SourceOther, nil},
+	{"shamrock green", "Shamrock Green", "#347c17", SourceOther, nil},
+	{"sheen green", "Sheen Green", "#8fd400", SourceOther, nil},
+	{"shimmering blush", "Shimmering Blush", "#d98695", SourceOther, nil},
+	{"shocking orange", "Shocking Orange", "#e55b3c", SourceOther, nil},
+	{"shocking pink", "Shocking Pink", "#fc0fc0", SourceCrayola, nil},
+	{"sienna", "Sienna", "#a0522d", SourceCSS, nil},
+	{"sienna (computer hope)", "Sienna (Computer Hope)", "#8a4117", SourceOther, []string{"sienna2"}},
+	{"sienna (dark)", "Sienna (Dark)", "#882d17", SourceOther, []string{"sienna1"}},
+	{"silk blue", "Silk Blue", "#488ac7", SourceOther, nil},
+	{"silver", "Silver", "#c0c0c0", SourceCSSBasic, nil},
+	{"silver chalice", "Silver Chalice", "#acacac", SourceOther, nil},
+	{"silver lake blue", "Silver Lake Blue", "#5d89ba", SourceOther, nil},
+	{"silver pink", "Silver Pink", "#c4aead", SourceOther, nil},
+	{"silver sand", "Silver Sand", "#bfc1c2", SourceOther, nil},
+	{"sinopia", "Sinopia", "#cb410b", SourceOther, nil},
+	{"siskin sprout yellow", "Siskin Sprout Yellow", "#7a942e", SourceOther, nil},
+	{"skobeloff", "Skobeloff", "#007474", SourceOther, nil},
+	{"sky blue", "Sky Blue", "#87ceeb", SourceCSS, []string{"skyblue"}},
+	{"sky blue (computer hope)", "Sky Blue (Computer Hope)", "#6698ff", SourceOther, []string{"sky blue1"}},
+	{"sky magenta", "Sky Magenta", "#cf71af", SourceOther, nil},
+	{"slate blue", "Slate Blue", "#6a5acd", SourceCSS, []string{"slateblue"}},
+	{"slate blue (computer hope)", "Slate Blue (Computer Hope)", "#737ca1", SourceOther, []string{"slate blue1"}},
+	{"slate gra1y", "Slate Gra1y", "#657383", SourceOther, nil},
+	{"slate gray", "Slate Gray", "#708090", SourceCSS, []string{"slate grey", "slategray", "slategrey"}},
+	{"slime green", "Slime Green", "#bce954", SourceOther, nil},
+	{"smalt", "Smalt", "#003399", SourceOther, nil},
+	{"smitten", "Smitten", "#c84186", SourceOther, nil},
+	{"smoke", "Smoke", "#738276", SourceOther, nil},
+	{"smokey gray", "Smokey Gray", "#726e6d", SourceOther, nil},
+	{"smokey topaz", "Smokey Topaz", "#933d41", SourceOther, nil},
+	{"snorkel blue", "Snorkel Blue", "#034f84", SourceOther, nil},
+	{"snow", "Snow", "#fffafa", SourceCSS, nil},
+	{"soap", "Soap", "#cec8ef", SourceOther, nil},
+	{"soldier green", "Soldier Green", "#545a2c", SourceOther, nil},
+	{"sonic silver", "Sonic Silver", "#757575", SourceOther, nil},
+	{"soybean", "Soybean", "#d2c29d", SourceOther, nil},
+	{"space cadet", "Space Cadet", "#1d2951", SourceOther, nil},
+	{"spanish bistre", "Spanish Bistre", "#80755a", SourceOther, nil},
+	{"spanish blue", "Spanish Blue", "#0070b8", SourceOther, nil},
+	{"spanish carmine", "Spanish Carmine", "#d10047", SourceOther, nil},
+	{"spanish crimson", "Spanish Crimson", "#e51a4c", SourceOther, nil},
+	{"spanish gray", "Spanish Gray", "#989898", SourceOther, nil},
+	{"spanish green", "Spanish Green", "#009150", SourceOther, nil},
+	{"spanish orange", "Spanish Orange", "#e86100", SourceOther, nil},
+	{"spanish pink", "Spanish Pink", "#f7bfbe", SourceOther, nil},
+	{"spanish red", "Spanish Red", "#e60026", SourceOther, nil},
+	{"spanish sky blue", "Spanish Sky Blue", "#00aae4", SourceOther, nil},
+	{"spanish violet", "Spanish Violet", "#4c2882", SourceOther, nil},
+	{"spanish viridian", "Spanish Viridian", "#007f5c", SourceOther, nil},
+	{"spiro disco ball", "Spiro Disco Ball", "#0fc0fc", SourceOther, nil},
+	{"spring bud", "Spring Bud", "#a7fc00", SourceOther, nil},
+	{"spring crocus", "Spring Crocus", "#bc70a4", SourceOther, nil},
+	{"spring green", "Spring Green", "#00ff7f", SourceCSS, []string{"springgreen"}},
+	{"spring green (computer hope)", "Spring Green (Computer Hope)", "#4aa02c", SourceOther, nil},
+	{"st patrick blue", "St Patrick Blue", "#23297a", SourceOther, nil},
+	{"star command blue", "Star Command Blue", "#007bb8", SourceOther, nil},
+	{"steel blue", "Steel Blue", "#4682b4", SourceCSS, []string{"steelblue"}},
+	{"steel blue (computer hope)", "Steel Blue (Computer Hope)", "#4863a0", SourceOther, []string{"steel blue1"}},
+	{"steel pink", "Steel Pink", "#cc3366", SourceOther, nil},
+	{"stil de grain yellow", "Stil de Grain Yellow", "#fada5e", SourceOther, nil},
+	{"stoplight go green", "Stoplight Go Green", "#57e964", SourceOther, nil},
+	{"storeroom brown", "Storeroom Brown", "#3d4035", SourceOther, nil},
+	{"stormcloud", "Stormcloud", "#4f666a", SourceOther, nil},
+	{"straw", "Straw", "#e4d96f", SourceOther, nil},
+	{"strawberry", "Strawberry", "#fc5a8d", SourceOther, nil},
+	{"sugar almond", "Sugar Almond", "#935529", SourceOther, nil},
+	{"sun yellow", "Sun Yellow", "#ffe87c", SourceOther, nil},
+	{"sunglow", "Sunglow", "#ffcc33", SourceCrayola, nil},
+	{"sunray", "Sunray", "#e3ab57", SourceOther, nil},
+	{"sunrise orange", "Sunrise Orange", "#e67451", SourceOther, nil},
+	{"sunset orange", "Sunset Orange", "#fd5e53", SourceCrayola, nil},
+	{"super pink", "Super Pink", "#cf6ba9", SourceOther, nil},
+	{"sweet corn", "Sweet Corn", "#f0ead6", SourceOther, nil},
+	{"sweet lilac", "Sweet Lilac", "#e8b5ce", SourceOther, nil},
+	{"tan", "Tan", "#d2b48c", SourceCSS, nil},
+	{"tan brown", "Tan Brown", "#ece5b6", SourceOther, nil},
+	{"tangelo", "Tangelo", "#f94d00", SourceOther, nil},
+	{"tangerine", "Tangerine", "#e78a61", SourceOther, nil},
+	{"tangerine tango", "Tangerine Tango", "#f05442", SourceOther, nil},
+	{"tango pink", "Tango Pink", "#e4717a", SourceOther, nil},
+	{"taupe", "Taupe", "#483c32", SourceOther, nil},
+	{"taupe gray", "Taupe Gray", "#8b8589", SourceOther, nil},
+	{"tawny port", "Tawny Port", "#672e3b", SourceOther, nil},
+	{"tea green", "Tea Green", "#d0f0c0", SourceOther, nil},
+	{"tea green (computer hope)", "Tea Green (Computer Hope)", "#ccfb5d", SourceOther, []string{"tea green1"}},
+	{"tea rose", "Tea Rose", "#f4c2c2", SourceOther, nil},
+	{"teal", "Teal", "#008080", SourceCSSBasic, nil},
+	{"teal blue", "Teal Blue", "#367588", SourceOther, nil},
+	{"teal deer", "Teal Deer", "#99e6b3", SourceOther, nil},
+	{"teal green", "Teal Green", "#00827f", SourceOther, nil},
+	{"telemagenta", "Telemagenta", "#cf3476", SourceOther, nil},
+	{"tenné (tawny)", "Tenné (Tawny)", "#cd5700", SourceOther, nil},
+	{"terra cotta", "Terra Cotta", "#e2725b", SourceOther, nil},
+	{"terrarium moss", "Terrarium Moss", "#616247", SourceOther, nil},
+	{"thistle", "Thistle", "#d8bfd8", SourceCSS, nil},
+	{"thistle (computer hope)", "Thistle (Computer Hope)", "#d2b9d3", SourceOther, []string{"thistle1"}},
+	{"thulian pink", "Thulian Pink", "#de6fa1", SourceOther, nil},
+	{"tickle me pink", "Tickle Me Pink", "#fc89ac", SourceCrayola, nil},
+	{"tiffany blue", "Tiffany Blue", "#0abab5", SourceOther, nil},
+	{"tiffany blue (computer hope)", "Tiffany Blue (Computer Hope)", "#81d8d0", SourceOther, []string{"tiffany blue1"}},
+	{"tiger orange", "Tiger Orange", "#c88141", SourceOther, nil},
+	{"tigerlily", "Tigerlily", "#e4583e", SourcePantone, nil},
+	{"tigers eye", "Tigers Eye", "#e08d3c", SourceOther, nil},
+	{"timberwolf", "Timberwolf", "#dbd7d2", SourceCrayola, nil},
+	{"titanium yellow", "Titanium Yellow", "#eee600", SourceOther, nil},
+	{"toffee", "Toffee", "#755139", SourceOther, nil},
+	{"tofu", "Tofu", "#eae6da", SourceOther, nil},
+	{"tomato", "Tomato", "#ff6347", SourceCSS, nil},
+	{"toolbox", "Toolbox", "#746cc0", SourceOther, nil},
+	{"topaz", "Topaz", "#ffc87c", SourceOther, nil},
+	{"tractor red", "Tractor Red", "#fd0e35", SourceOther, nil},
+	{"trolley grey", "Trolley Grey", "#808080", SourceOther, nil},
+	{"tron blue", "Tron Blue", "#7dfdfe", SourceOther, nil},
+	{"tropical rain forest", "Tropical Rain Forest", "#00755e", SourceOther, nil},
+	{"true blue", "True Blue", "#0073cf", SourceOther, nil},
+	{"true red", "True Red", "#c02034", SourcePantone, nil},
+	{"tufts blue", "Tufts Blue", "#417dc1", SourceOther, nil},
+	{"tulip", "Tulip", "#ff878d", SourceOther, nil},
+	{"tulip pink", "Tulip Pink", "#c25a7c", SourceOther, nil},
+	{"tumbleweed", "Tumbleweed", "#deaa88", SourceCrayola, nil},
+	{"turkish rose", "Turkish Rose", "#b57281", SourceOther, nil},
+	{"turmeric", "Turmeric", "#fe840e", SourceOther, nil},
+	{"turquoise", "Turquoise", "#40e0d0", SourceCSS, nil},
+	{"turquoise (computer hope)", "Turquoise (Computer Hope)", "#43c6db", SourceOther, []string{"turquoise2"}},
+	{"turquoise (dark)", "Turquoise (Dark)", "#30d5c8", SourceOther, []string{"turquoise1"}},
+	{"turquoise blue", "Turquoise Blue", "#00ffef", SourceOther, nil},
+	{"turquoise green", "Turquoise Green", "#a0d6b4", SourceOther, nil},
+	{"tuscan", "Tuscan", "#fad6a5", SourceOther, nil},
+	{"tuscan brown", "Tuscan Brown", "#6f4e37", SourceOther, nil},
+	{"tuscan red", "Tuscan Red", "#7c4848", SourceOther, nil},
+	{"tuscan tan", "Tuscan Tan", "#a67b5b", SourceOther, nil},
+	{"tuscany", "Tuscany", "#c09999", SourceOther, nil},
+	{"twilight lavender", "Twilight Lavender", "#8a496b", SourceOther, nil},
+	{"tyrian purple", "Tyrian Purple", "#66023c", SourceOther, nil},
+	{"tyrian purple (computer hope)", "Tyrian Purple (Computer Hope)", "#c45aec", SourceOther, []string{"tyrian purple1"}},
+	{"ua blue", "Ua Blue", "#0033aa", SourceOther, nil},
+	{"ua red", "Ua Red", "#d9004c", SourceOther, nil},
+	{"ube", "Ube", "#8878c3", SourceOther, nil},
+	{"ucla blue", "Ucla Blue", "#536895", SourceOther, nil},
+	{"ucla gold", "Ucla Gold", "#ffb300", SourceOther, nil},
+	{"ufo green", "Ufo Green", "#3cd070", SourceOther, nil},
+	{"ultimate grey", "Ultimate Grey", "#939597", SourceOther, nil},
+	{"ultra pink", "Ultra Pink", "#ff6fff", SourceOther, nil},
+	{"ultra violet", "Ultra Violet", "#5f4b8b", SourcePantone, nil},
+	{"ultramarine", "Ultramarine", "#120a8f", SourceOther, nil},
+	{"ultramarine blue", "Ultramarine Blue", "#4166f5", SourceOther, nil},
+	{"umber", "Umber", "#635147", SourceOther, nil},
+	{"unbleached silk", "Unbleached Silk", "#ffddca", SourceOther, nil},
+	{"united nations blue", "United Nations Blue", "#5b92e5", SourceOther, nil},
+	{"university of california gold", "University of California Gold", "#b78727", SourceOther, nil},
+	{"unmellow yellow", "Unmellow Yellow", "#ffff66", SourceCrayola, nil},
+	{"up forest green", "Up Forest Green", "#014421", SourceOther, nil},
+	{"up maroon", "Up Maroon", "#7b1113", SourceOther, nil},
+	{"upsdell red", "Upsdell Red", "#ae2029", SourceOther, nil},
+	{"urobilin", "Urobilin", "#e1ad21", SourceOther, nil},
+	{"usafa blue", "USAFA Blue", "#004f98", SourceOther, nil},
+	{"usc cardinal", "Usc Cardinal", "#990000", SourceOther, nil},
+	{"usc gold", "Usc Gold", "#ffcc00", SourceOther, nil},
+	{"utah crimson", "Utah Crimson", "#d3003f", SourceOther, nil},
+	{"valentine red", "Valentine Red", "#e55451", SourceOther, nil},
+	{"valiant poppy", "Valiant Poppy", "#bd3d3a", SourceOther, nil},
+	{"vampire gray", "Vampire Gray", "#565051", SourceOther, nil},
+	{"vanilla", "Vanilla", "#f3e5ab", SourceOther, nil},
+	{"vanilla custard", "Vanilla Custard", "#f3e0be", SourceOther, nil},
+	{"vanilla ice", "Vanilla Ice", "#f38fa9", SourceOther, nil},
+	{"vegas gold", "Vegas Gold", "#c5b358", SourceOther, nil},
+	{"velvet maroon", "Velvet Maroon", "#7e354d", SourceOther, nil},
+	{"venetian red", "Venetian Red", "#c80815", SourceOther, nil},
+	{"venom green", "Venom Green", "#728c00", SourceOther, nil},
+	{"verdigris", "Verdigris", "#43b3ae", SourceOther, nil},
+	{"vermilion", "Vermilion", "#e34234", SourceOther, nil},
+	{"veronica", "Veronica", "#a020f0", SourceOther, nil},
+	{"very peri", "Very Peri", "#6667ab", SourcePantone, nil},
+	{"viola purple", "Viola Purple", "#7e587e", SourceOther, nil},
+	{"violet", "Violet", "#ee82ee", SourceCSS, []string{"violet2"}},
+	{"violet (color wheel)", "Violet (Color Wheel)", "#7f00ff", SourceOther, nil},
+	{"violet (computer hope)", "Violet (Computer Hope)", "#8d38c9", SourceOther, []string{"violet1"}},
+	{"violet (ryb)", "Violet (RYB)", "#8601af", SourceRYB, nil},
+	{"violet (spectral)", "Violet (Spectral)", "#8f00ff", SourceOther, nil},
+	{"violet blue", "Violet Blue", "#324ab2", SourceOther, nil},
+	{"violet red", "Violet Red", "#f75394", SourceOther, nil},
+	{"violet red (computer hope)", "Violet Red (Computer Hope)", "#f6358a", SourceOther, []string{"violet red1"}},
+	{"viridian", "Viridian", "#40826d", SourceOther, nil},
+	{"viridian green", "Viridian Green", "#009698", SourceOther, nil},
+	{"vivid auburn", "Vivid Auburn", "#922724", SourceOther, nil},
+	{"vivid burgundy", "Vivid Burgundy", "#9f1d35", SourceOther, nil},
+	{"vivid cerise", "Vivid Cerise", "#da1d81", SourceOther, nil},
+	{"vivid lime green", "Vivid Lime Green", "#a6d608", SourceOther, nil},
+	{"vivid magenta", "Vivid Magenta", "#ba2649", SourceOther, nil},
+	{"vivid orchid", "Vivid Orchid", "#cc00ff", SourceOther, nil},
+	{"vivid sky blue", "Vivid Sky Blue", "#00ccff", SourceOther, nil},
+	{"vivid tangerine", "Vivid Tangerine", "#ffa089", SourceCrayola, nil},
+	{"vivid violet", "Vivid Violet", "#9f00ff", SourceCrayola, nil},
+	{"volt", "Volt", "#ceff00", SourceOther, nil},
+	{"warm black", "Warm Black", "#004242", SourceOther, nil},
+	{"warm sand", "Warm Sand", "#c0ab8e", SourceOther, nil},
+	{"water", "Water", "#ebf4fa", SourceOther, nil},
+	{"watermelon pink", "Watermelon Pink", "#fc6c85", SourceOther, nil},
+	{"watermelon yellow", "Watermelon Yellow", "#eeff1b", SourceOther, nil},
+	{"waterspout", "Waterspout", "#a4f4f9", SourceOther, nil},
+	{"wenge", "Wenge", "#645452", SourceOther, nil},
+	{"wheat", "Wheat", "#f5deb3", SourceCSS, nil},
+	{"white", "White", "#ffffff", SourceCSSBasic, nil},
+	{"white smoke", "White Smoke", "#f5f5f5", SourceCSS, []string{"whitesmoke"}},
+	{"wild blue yonder", "Wild Blue Yonder", "#a2add0", SourceCrayola, nil},
+	{"wild orchid", "Wild Orchid", "#d470a2", SourceOther, nil},
+	{"wild strawberry", "Wild Strawberry", "#ff43a4", SourceCrayola, nil},
+	{"wild watermelon", "Wild Watermelon", "#fc6c85", SourceCrayola, nil},
+	{"willow", "Willow", "#9a8b4f", SourceOther, nil},
+	{"willow dye", "Willow Dye", "#8c9e5e", SourceOther, nil},
+	{"willpower orange", "Willpower Orange", "#fd5800", SourceOther, nil},
+	{"windows blue", "Windows Blue", "#357ec7", SourceOther, nil},
+	{"windsor tan", "Windsor Tan", "#a75502", SourceOther, nil},
+	{"wine", "Wine", "#722f37", SourceOther, nil},
+	{"wine dregs", "Wine Dregs", "#673147", SourceOther, nil},
+	{"wisteria", "Wisteria", "#c9a0dc", SourceCrayola, nil},
+	{"wisteria purple", "Wisteria Purple", "#c6aec7", SourceOther, nil},
+	{"wood", "Wood", "#966f33", SourceOther, nil},
+	{"wood brown", "Wood Brown", "#c19a6b", SourceOther, nil},
+	{"xanadu", "Xanadu", "#738678", SourceOther, nil},
+	{"yale blue", "Yale Blue", "#0f4d92", SourceOther, nil},
+	{"yankees blue", "Yankees Blue", "#1c2841", SourceOther, nil},
+	{"yellow", "Yellow", "#ffff00", SourceCSSBasic, nil},
+	{"yellow (munsell)", "Yellow (Munsell)", "#efcc00", SourceMunsell, nil},
+	{"yellow (ryb)", "Yellow (RYB)", "#fefe33", SourceRYB, nil},
+	{"yellow green", "Yellow Green", "#9acd32", SourceCSS, []string{"yellowgreen"}},
+	{"yellow green (computer hope)", "Yellow Green (Computer Hope)", "#52d017", SourceOther, nil},
+	{"yellow orange", "Yellow Orange", "#ffae42", SourceOther, nil},
+	{"yellow rose", "Yellow Rose", "#fff000", SourceOther, nil},
+	{"yellow-green (crayola)", "Yellow-Green (Crayola)", "#c5e384", SourceCrayola, nil},
+	{"zaffre", "Zaffre", "#0014a8", SourceOther, nil},
+	{"zombie green", "Zombie Green", "#54c571", SourceOther, nil},
 }
@@ -0,0 +1,42 @@
+package htmlcolors
+
+import (
+	"regexp"
+	"testing"
+)
+
+// TestCSSKeywords checks keywords the table once got wrong: each must
+// give the browser's color and be in the css palette source.
+func TestCSSKeywords(t *testing.T) {
+	cases := map[string]string{
+		"khaki":          "#f0e68c",
+		"moccasin":       "#ffe4b5",
+		"violet":         "#ee82ee",
+		"mediumorchid":   "#ba55d3",
+		"royalblue":      "#4169e1",
+		"lightsteelblue": "#b0c4de",
+		"darkslategrey":  "#2f4f4f",
+	}
+	for name, want := range cases {
+		c, ok := lookupName(name)
+		if !ok || want != c.Hex || !isCSS(c.Source) {
+			t.Errorf("%s is %s from %q, want %s from css", name, c.Hex, c.Source, want)
+		}
+	}
+}
+
+// TestDisplayNames checks the naming convention of the table: no name
+// tells colors apart by a trailing digit, and no display name runs its
+// words together.
+func TestDisplayNames(t *testing.T) {
+	digit := regexp.MustCompile(`[a-z ]\d$`)
+	camel := regexp.MustCompile(`[a-z][A-Z]`)
+	for _, c := range colorTable {
+		if digit.MatchString(c.Name) && "mordant red 19" != c.Name {
+			t.Errorf("%q is told apart by a digit", c.Name)
+		}
+		if camel.MatchString(c.Display) {
+			t.Errorf("%q runs its words together", c.Display)
+		}
+	}
+}
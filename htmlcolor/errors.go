@@ -0,0 +1,51 @@
+package htmlcolors
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Errors returned by the package, wrapped with the value concerned.
+// Test for them with errors.Is.
+var (
+	// ErrUnknownColor is returned for a string that is neither a hex
+	// value nor a name StringToColor knows.
+	ErrUnknownColor = errors.New("unknown color")
+
+	// ErrInvalidHex is returned for a value that should be "#RRGGBB"
+	// (as StringToColor returns) but is not.
+	ErrInvalidHex = errors.New("not a #RRGGBB color")
+
+	// ErrContrastUnsatisfiable is returned when no color could be found
+	// that meets the minimum contrast and distance against a background.
+	ErrContrastUnsatisfiable = errors.New("no color meets the contrast and distance")
+
+	// ErrRandom is returned when the random source fails.
+	ErrRandom = errors.New("could not read the random source")
+)
+
+// ParseColor returns the "#rrggbb" value of s, which may be anything
+// StringToColor accepts. If s is not a color, the error wraps
+// ErrUnknownColor.
+func ParseColor(s string) (hex string, err error) {
+	hex, ok := StringToColor(s)
+	if !ok {
+		return "", fmt.Errorf("%w %q", ErrUnknownColor, s)
+	}
+	return hex, nil
+}
+
+// unsatisfiable returns an error wrapping ErrContrastUnsatisfiable for
+// minContrast and minDistance against bg ("#RRGGBB"), giving up after
+// attempts, with the most contrast and distance bg allows (see
+// Achievable).
+func unsatisfiable(minContrast int, minDistance int, bg string, attempts int) error {
+	contrast, distance, err := Achievable(bg)
+	if nil != err {
+		return fmt.Errorf("%w: %d%% contrast and %d%% distance against %s after %d attempts",
+			ErrContrastUnsatisfiable, minContrast, minDistance, bg, attempts)
+	}
+	return fmt.Errorf("%w: %d%% contrast and %d%% distance against %s after %d attempts "+
+		"(at most %.1f%% contrast and %.1f%% distance are achievable)",
+		ErrContrastUnsatisfiable, minContrast, minDistance, bg, attempts, contrast, distance)
+}
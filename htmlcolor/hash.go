@@ -0,0 +1,98 @@
+package htmlcolors
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+)
+
+// maxHashAttempts bounds the deterministic walk through the invented
+// color space. It matches the attempt limit used by InventColor.
+const maxHashAttempts = 500
+
+// tokenHash returns a stable 64-bit FNV-1a hash of the salt, the token
+// and an attempt counter. The separators keep ("ab", "c") and ("a", "bc")
+// from colliding. FNV is used (rather than crypto/rand) because the
+// same token must produce the same color on every run and every machine.
+func tokenHash(salt string, token string, attempt uint32) uint64 {
+	var counter [4]byte
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(salt))
+	_, _ = h.Write([]byte{0})
+	_, _ = h.Write([]byte(token))
+	_, _ = h.Write([]byte{0})
+	binary.BigEndian.PutUint32(counter[:], attempt)
+	_, _ = h.Write(counter[:])
+	return h.Sum64()
+}
+
+// hashToHex turns the low 24 bits of a hash into a "#rrggbb" color.
+func hashToHex(sum uint64) string {
+	return fmt.Sprintf("#%02x%02x%02x", byte(sum>>16), byte(sum>>8), byte(sum))
+}
+
+// HashColor deterministically selects a foreground color for token, so that
+// the same token (and salt) always maps to the same color, in the manner of
+// IRC nick coloring. The starting candidate is derived from a hash of the
+// token; if it does not satisfy the minimum contrast and distance against
+// backColor, the named palette is walked forward from that point (or, when
+// invent is set, the invented color space is walked by re-hashing with an
+// attempt counter) until a candidate does.
+//
+// minContrast and minDistance are percentages, as for RandomColor and
+// InventColor. If no named color satisfies the constraints, the invented
+// space is tried; if that fails too, black or white is returned, whichever
+// contrasts better with the background, with an error wrapping
+// ErrContrastUnsatisfiable. name is "" for invented colors. No backColor
+// means white; an unknown one is an error wrapping ErrUnknownColor.
+func HashColor(token string, salt string, backColor string,
+	minContrast int, minDistance int, invent bool) (name string, hex string, err error) {
+	bg := "#FFFFFF" // white
+	if "" != backColor {
+		bg, err = ParseColor(backColor)
+		if nil != err {
+			return "", "", err
+		}
+	}
+
+	satisfies := func(fg string) bool {
+		return Satisfies(fg, bg, minContrast, minDistance)
+	}
+
+	if !invent {
+		ixStart := int(tokenHash(salt, token, 0) % uint64(len(htmlColorArray)))
+		for step := 0; step < len(htmlColorArray); step++ {
+			ix := (ixStart + step) % len(htmlColorArray)
+			if satisfies(htmlColorArray[ix].hex) {
+				return htmlColorArray[ix].name, htmlColorArray[ix].hex, nil
+			}
+		}
+	}
+
+	for attempt := uint32(0); attempt < maxHashAttempts; attempt++ {
+		fg := hashToHex(tokenHash(salt, token, attempt))
+		if satisfies(fg) {
+			return "", fg, nil
+		}
+	}
+
+	err = unsatisfiable(minContrast, minDistance, bg, maxHashAttempts)
+	if "#000000" == blackOrWhite(bg) {
+		return "black", "#000000", err
+	}
+	return "white", "#ffffff", err
+}
+
+// HashBackground deterministically selects a background color for token,
+// for use with the anti-color mode. No constraints apply to the background;
+// the foreground is then chosen against it with HashColor. The background
+// is hashed under a different salt than the foreground so that the two are
+// not trivially correlated.
+func HashBackground(token string, salt string, invent bool) (name string, hex string) {
+	sum := tokenHash(salt+"\x00background", token, 0)
+	if invent {
+		return "", hashToHex(sum)
+	}
+	ix := int(sum % uint64(len(htmlColorArray)))
+	return htmlColorArray[ix].name, htmlColorArray[ix].hex
+}
@@ -0,0 +1,100 @@
+package htmlcolors
+
+import (
+	"math"
+	"sort"
+	"sync"
+)
+
+// kdNode is a node of the k-d tree over the named colors in CIE L*a*b*,
+// which makes NearestColor a logarithmic search rather than a scan of
+// the whole palette.
+type kdNode struct {
+	lab   [3]float64
+	ix    int // index into htmlColorArray
+	axis  int
+	left  *kdNode
+	right *kdNode
+}
+
+var kdRoot *kdNode
+var kdOnce sync.Once
+
+// buildKD builds the k-d tree over nodes, splitting on L, a and b in
+// turn at the median, and returns its root.
+func buildKD(nodes []kdNode, depth int) *kdNode {
+	if 0 == len(nodes) {
+		return nil
+	}
+	axis := depth % 3
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].lab[axis] < nodes[j].lab[axis] })
+	mid := len(nodes) / 2
+	n := nodes[mid]
+	n.axis = axis
+	n.left = buildKD(nodes[:mid], depth+1)
+	n.right = buildKD(nodes[mid+1:], depth+1)
+	return &n
+}
+
+// kdTree returns the k-d tree of the named colors, building it on first
+// use (htmlColorArray is only complete after init).
+func kdTree() *kdNode {
+	kdOnce.Do(func() {
+		nodes := make([]kdNode, len(htmlColorArray))
+		for ix, c := range htmlColorArray {
+			l, a, b, _ := Lab(c.hex) // palette colors are all "#rrggbb"
+			nodes[ix] = kdNode{lab: [3]float64{l, a, b}, ix: ix}
+		}
+		kdRoot = buildKD(nodes, 0)
+	})
+	return kdRoot
+}
+
+// resetKDTree drops the k-d tree, for it to be built again over a new
+// palette on next use.
+func resetKDTree() {
+	kdOnce = sync.Once{}
+	kdRoot = nil
+}
+
+// search finds the node nearest to target below n, given the best node
+// and squared distance found so far. Ties go to the lower palette
+// index, so the result does not depend on the shape of the tree.
+func (n *kdNode) search(target [3]float64, best *kdNode, bestSq float64) (*kdNode, float64) {
+	if nil == n {
+		return best, bestSq
+	}
+	var sq float64
+	for ax := 0; ax < 3; ax++ {
+		d := n.lab[ax] - target[ax]
+		sq += d * d
+	}
+	if sq < bestSq || (sq == bestSq && nil != best && n.ix < best.ix) {
+		best, bestSq = n, sq
+	}
+
+	near, far := n.left, n.right
+	diff := target[n.axis] - n.lab[n.axis]
+	if diff > 0 {
+		near, far = n.right, n.left
+	}
+	best, bestSq = near.search(target, best, bestSq)
+	if diff*diff <= bestSq {
+		best, bestSq = far.search(target, best, bestSq)
+	}
+	return best, bestSq
+}
+
+// NearestColor returns the named color perceptually closest to hex, a
+// "#RRGGBB" color, and the distance to it as DeltaE (0 for an exact
+// match). The search runs over a k-d tree of the palette in L*a*b*.
+// Any other format is an error wrapping ErrInvalidHex.
+func NearestColor(hex string) (name string, nearest string, dist float64, err error) {
+	l, a, b, err := Lab(hex)
+	if nil != err {
+		return "", "", 0, err
+	}
+	best, bestSq := kdTree().search([3]float64{l, a, b}, nil, math.Inf(1))
+	c := htmlColorArray[best.ix]
+	return c.name, c.hex, math.Sqrt(bestSq), nil
+}
@@ -0,0 +1,80 @@
+package htmlcolors
+
+import (
+	"fmt"
+	"strings"
+)
+
+// sources of the built-in colors (see ColorInfo)
+const (
+	SourceCSSBasic = "css-basic" // the 16 colors of HTML 4 and CSS Level 1
+	SourceCSS      = "css"       // the other named colors of CSS Color Level 4
+	SourceCrayola  = "crayola"
+	SourceMunsell  = "munsell"
+	SourceRYB      = "ryb"
+	SourceNCS      = "ncs"
+	SourcePantone  = "pantone" // Pantone colors of the year
+	SourceOther    = "other"   // everything else, mostly from common use
+)
+
+// sourceNames lists the sources in the order Sources reports them.
+var sourceNames = []string{SourceCSSBasic, SourceCSS, SourceCrayola,
+	SourceMunsell, SourceRYB, SourceNCS, SourcePantone, SourceOther}
+
+// ColorInfo is one entry of the built-in color table: a color's name
+// (lowercase), its display name, its "#rrggbb" value, the source it
+// comes from, and other spellings of the name that mean the same color.
+type ColorInfo struct {
+	Name    string
+	Display string
+	Hex     string
+	Source  string
+	Aliases []string
+}
+
+// Sources returns the names of the sources a palette can be limited to.
+func Sources() []string {
+	return append([]string(nil), sourceNames...)
+}
+
+// SetPaletteSources limits the palette that RandomColor, HashColor,
+// RandNamedColor, NearestColor and Palette choose from to the colors of
+// the given sources. "css" includes "css-basic", as CSS Level 4 names
+// all 16 basic colors too. No sources, or "all", restore the whole
+// table. StringToColor still accepts every name. It is not safe to call
+// while other goroutines use the palette.
+func SetPaletteSources(sources ...string) error {
+	wanted := make(map[string]bool, len(sources))
+	for _, src := range sources {
+		src = strings.ToLower(strings.TrimSpace(src))
+		if "all" == src {
+			wanted = nil
+			break
+		}
+		if !isSource(src) {
+			return fmt.Errorf("a palette source must be one of %s or all, not %q",
+				strings.Join(sourceNames, ", "), src)
+		}
+		wanted[src] = true
+	}
+	if 0 < len(wanted) && wanted[SourceCSS] {
+		wanted[SourceCSSBasic] = true
+	}
+
+	palette, dups := buildPalette(func(c ColorInfo) bool { return 0 == len(wanted) || wanted[c.Source] })
+	if 0 == len(palette) {
+		return fmt.Errorf("the palette sources %s have no colors", strings.Join(sources, ", "))
+	}
+	setPalette(palette, dups)
+	return nil
+}
+
+// isSource reports whether src is one of sourceNames.
+func isSource(src string) bool {
+	for _, name := range sourceNames {
+		if name == src {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,202 @@
+package htmlcolors
+
+import (
+	"math"
+	"strings"
+)
+
+// WCAGLevel is a WCAG 2 conformance level for text contrast, with the
+// smallest contrast ratio that meets it.
+// https://www.w3.org/TR/WCAG21/#contrast-minimum
+type WCAGLevel struct {
+	Name  string
+	Ratio float64
+}
+
+// WCAGLevels are the text contrast levels, AA and AAA, for normal and
+// for large (18pt, or 14pt bold) text.
+var WCAGLevels = []WCAGLevel{
+	{"AA", 4.5},
+	{"AA-large", 3.0},
+	{"AAA", 7.0},
+	{"AAA-large", 4.5},
+}
+
+// ParseWCAGLevel returns the level called name, ignoring case.
+func ParseWCAGLevel(name string) (level WCAGLevel, ok bool) {
+	for _, level = range WCAGLevels {
+		if strings.EqualFold(level.Name, strings.TrimSpace(name)) {
+			return level, true
+		}
+	}
+	return level, false
+}
+
+// FixContrast returns the color closest to fg (by DeltaE) that has a
+// contrast ratio of at least ratio against bg, keeping the hue and
+// saturation of fg and changing only its lightness. fg itself is
+// returned if it already meets ratio. If no lightness does, ok is false
+// and hex is black or white, whichever has more contrast. fg or bg in a
+// format other than "#RRGGBB" is an error wrapping ErrInvalidHex.
+func FixContrast(fg string, bg string, ratio float64) (hex string, ok bool, err error) {
+	const step = 0.005
+
+	r, err := ContrastRatio(fg, bg)
+	if nil != err {
+		return "", false, err
+	}
+	if r >= ratio {
+		return fg, true, nil
+	}
+	h, s, l, err := HSL(fg)
+	if nil != err {
+		return "", false, err
+	}
+	best, bestDelta := "", math.Inf(1)
+	for _, dir := range []float64{-1, 1} {
+		for lx := l + dir*step; lx >= -step && lx <= 1+step; lx += dir * step {
+			c := HSLToHex(h, s, math.Max(0, math.Min(1, lx)))
+			// c and bg are both valid by now
+			if r, _ = ContrastRatio(c, bg); r >= ratio {
+				if d, _ := DeltaE(fg, c); d < bestDelta {
+					best, bestDelta = c, d
+				}
+				break
+			}
+		}
+	}
+	if "" != best {
+		return best, true, nil
+	}
+	return blackOrWhite(bg), false, nil
+}
+
+// Luminance returns the WCAG relative luminance of a "#RRGGBB" color,
+// from 0 (black) to 1 (white). Any other format is an error wrapping
+// ErrInvalidHex, as it is for ContrastRatio, APCA, Lab and DeltaE.
+func Luminance(hex string) (float64, error) {
+	r, g, b, err := getRGB(hex)
+	if nil != err {
+		return 0, err
+	}
+	return relativeLuminance(uint8(r), uint8(g), uint8(b)), nil
+}
+
+// ContrastRatio returns the WCAG 2 contrast ratio of two "#RRGGBB"
+// colors, from 1 (no contrast) to 21 (black on white). The order of the
+// colors does not matter.
+func ContrastRatio(a string, b string) (float64, error) {
+	l1, err := Luminance(a)
+	if nil != err {
+		return 0, err
+	}
+	l2, err := Luminance(b)
+	if nil != err {
+		return 0, err
+	}
+	if l1 < l2 {
+		l1, l2 = l2, l1
+	}
+	return (l1 + 0.05) / (l2 + 0.05), nil
+}
+
+// APCA returns the APCA (0.0.98G-4g) lightness contrast Lc of text on
+// a background, both "#RRGGBB" colors. Unlike the WCAG ratio it depends
+// on which color is the text: Lc is positive for dark text on a light
+// background and negative for light text on a dark one, and runs to
+// about ±106. Lc 60 is roughly the APCA equivalent of AA for body text.
+// https://github.com/Myndex/apca-w3
+func APCA(text string, bg string) (float64, error) {
+	const (
+		blkThrs   = 0.022
+		blkClmp   = 1.414
+		deltaYMin = 0.0005
+		scale     = 1.14
+		loOffset  = 0.027
+		loClip    = 0.1
+	)
+	screenY := func(hex string) (float64, error) {
+		r, g, b, err := getRGB(hex)
+		if nil != err {
+			return 0, err
+		}
+		y := 0.2126729*math.Pow(float64(r)/255.0, 2.4) +
+			0.7151522*math.Pow(float64(g)/255.0, 2.4) +
+			0.0721750*math.Pow(float64(b)/255.0, 2.4)
+		if y < blkThrs {
+			y += math.Pow(blkThrs-y, blkClmp) // soft clamp near black
+		}
+		return y, nil
+	}
+
+	yText, err := screenY(text)
+	if nil != err {
+		return 0, err
+	}
+	yBg, err := screenY(bg)
+	if nil != err {
+		return 0, err
+	}
+	if math.Abs(yBg-yText) < deltaYMin {
+		return 0, nil
+	}
+
+	var lc float64
+	if yBg > yText { // dark text on a light background
+		sapc := (math.Pow(yBg, 0.56) - math.Pow(yText, 0.57)) * scale
+		if sapc >= loClip {
+			lc = sapc - loOffset
+		}
+	} else { // light text on a dark background
+		sapc := (math.Pow(yBg, 0.65) - math.Pow(yText, 0.62)) * scale
+		if sapc <= -loClip {
+			lc = sapc + loOffset
+		}
+	}
+	return lc * 100.0, nil
+}
+
+// Lab returns the CIE L*a*b* coordinates of a "#RRGGBB" color, for the
+// D65 white point. L runs from 0 to 100; a and b are roughly ±128.
+func Lab(hex string) (l, a, b float64, err error) {
+	r, g, bl, err := getRGB(hex)
+	if nil != err {
+		return 0, 0, 0, err
+	}
+	linear := func(c int) float64 {
+		v := float64(c) / 255.0
+		if v <= 0.04045 {
+			return v / 12.92
+		}
+		return math.Pow((v+0.055)/1.055, 2.4)
+	}
+	lr, lg, lb := linear(r), linear(g), linear(bl)
+
+	x := (0.4124564*lr + 0.3575761*lg + 0.1804375*lb) / 0.95047
+	y := 0.2126729*lr + 0.7151522*lg + 0.0721750*lb
+	z := (0.0193339*lr + 0.1191920*lg + 0.9503041*lb) / 1.08883
+
+	f := func(t float64) float64 {
+		if t > 216.0/24389.0 {
+			return math.Cbrt(t)
+		}
+		return (24389.0/27.0*t + 16.0) / 116.0
+	}
+	fx, fy, fz := f(x), f(y), f(z)
+	return 116.0*fy - 16.0, 500.0 * (fx - fy), 200.0 * (fy - fz), nil
+}
+
+// DeltaE returns the perceptual distance (CIE76 ΔE*ab, the Euclidean
+// distance in L*a*b*) of two "#RRGGBB" colors. A ΔE of about 2.3 is
+// the smallest difference most people notice.
+func DeltaE(a string, b string) (float64, error) {
+	l1, a1, b1, err := Lab(a)
+	if nil != err {
+		return 0, err
+	}
+	l2, a2, b2, err := Lab(b)
+	if nil != err {
+		return 0, err
+	}
+	return math.Sqrt((l1-l2)*(l1-l2) + (a1-a2)*(a1-a2) + (b1-b2)*(b1-b2)), nil
+}
@@ -0,0 +1,53 @@
+package htmlcolors
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestMeasuresInvalidHex checks that each measure rejects a color that
+// is not "#RRGGBB" with ErrInvalidHex, in either position, rather than
+// returning NaN.
+func TestMeasuresInvalidHex(t *testing.T) {
+	measures := map[string]func(a, b string) error{
+		"Luminance":     func(a, _ string) error { _, err := Luminance(a); return err },
+		"ContrastRatio": func(a, b string) error { _, err := ContrastRatio(a, b); return err },
+		"APCA":          func(a, b string) error { _, err := APCA(a, b); return err },
+		"Lab":           func(a, _ string) error { _, _, _, err := Lab(a); return err },
+		"DeltaE":        func(a, b string) error { _, err := DeltaE(a, b); return err },
+		"HSL":           func(a, _ string) error { _, _, _, err := HSL(a); return err },
+		"NearestColor":  func(a, _ string) error { _, _, _, err := NearestColor(a); return err },
+		"FixContrast":   func(a, b string) error { _, _, err := FixContrast(a, b, 4.5); return err },
+	}
+	for name, measure := range measures {
+		if err := measure("#000000", "#ffffff"); nil != err {
+			t.Errorf("%s: %v for valid colors", name, err)
+		}
+		for _, pair := range [][2]string{{"red", "#ffffff"}, {"#000000", "#fff"}, {"#00000g", "#ffffff"}} {
+			if "#ffffff" != pair[1] && ("Luminance" == name || "Lab" == name ||
+				"HSL" == name || "NearestColor" == name) {
+				continue // these take one color
+			}
+			if err := measure(pair[0], pair[1]); !errors.Is(err, ErrInvalidHex) {
+				t.Errorf("%s(%q, %q) = %v, want ErrInvalidHex", name, pair[0], pair[1], err)
+			}
+		}
+	}
+}
+
+func TestContrastRatio(t *testing.T) {
+	cases := []struct {
+		a, b string
+		want float64
+	}{
+		{"#000000", "#ffffff", 21},
+		{"#ffffff", "#000000", 21},
+		{"#777777", "#777777", 1},
+	}
+	for _, c := range cases {
+		got, err := ContrastRatio(c.a, c.b)
+		if nil != err || 1e-9 < got-c.want || 1e-9 < c.want-got {
+			t.Errorf("ContrastRatio(%s, %s) = %f, %v, want %f", c.a, c.b, got, err, c.want)
+		}
+	}
+}
@@ -2,100 +2,311 @@ package main
 
 import (
 	"bufio"
+	"context"
 	"fmt"
 	"io"
-	"log"
+	"log/slog"
 	"os"
 	"path/filepath"
 	"runtime"
+	"slices"
 	"strings"
 	"sync"
+
+	"github.com/spf13/pflag"
 )
 
-var xLogFile *os.File
-var xLogBuffer *bufio.Writer
-var xLog log.Logger
+// log formats (see --log-format)
+const (
+	logFormatText = "text"
+	logFormatJSON = "json"
+)
 
-// flushLog flushes the log buffer if it is not nil.
-// If flushing the buffer results in an error, it logs the error message to standard output.
-// This function is typically used to ensure that all log messages are written before shutting down the logging service.
-func flushCloseLog() {
-	if nil != xLogBuffer {
-		err := xLogBuffer.Flush()
-		if nil != err {
-			_, _ = fmt.Fprintf(os.Stdout, "huh? could not flush xLogBuffer because %s", err.Error())
-		}
-	}
+// logFormats lists the values --log-format accepts.
+var logFormats = []string{logFormatText, logFormatJSON}
+
+// logLevelNames lists the values --log-level accepts, quietest last.
+var logLevelNames = []string{"debug", "info", "warn", "error"}
+
+// logFileNone is the --log-file value that turns the log file off.
+const logFileNone = "none"
+
+// logFileName is the name of the default log file (see logFilePath).
+const logFileName = "madcolor.log"
+
+// the log file is rotated when it reaches logMaxSize bytes, and
+// logKeep rotated logs are kept
+const (
+	logMaxSize = 1 << 20
+	logKeep    = 3
+)
+
+var FlagLogFormat string
+var FlagLogLevel string
+var FlagLogFile string
+
+var xLogFile *rotatingFile
+
+// xLogLevel is the level of xLog. Until configureLog runs, only warnings
+// and errors are logged.
+var xLogLevel = new(slog.LevelVar)
+
+// xLog is the program's logger. It starts out writing text to stderr,
+// so that errors in the arguments are reported; configureLog then sets
+// it up as the flags ask. It never writes to stdout, which belongs to
+// the output.
+var xLog = slog.New(newLogHandler(os.Stderr, logFormatText))
+
+func init() {
+	xLogLevel.Set(slog.LevelWarn)
 }
 
-var closeLogMutex sync.Mutex
+// addLogFlags defines --log-format, --log-level, --log-file and
+// --error-format on fs. Every command has them.
+func addLogFlags(fs *pflag.FlagSet) {
+	fs.StringVarP(&FlagLogFormat, "log-format", "", logFormatText,
+		"Format of log records: text or json")
+	fs.StringVarP(&FlagLogLevel, "log-level", "", "",
+		"Least severe level logged: debug, info, warn or error "+
+			"(default warn, info with --verbose, debug with --debug)")
+	fs.StringVarP(&FlagLogFile, "log-file", "", "",
+		"File the log is also written to, or none "+
+			"(default $XDG_STATE_HOME/madcolor/"+logFileName+")")
+	fs.StringVarP(&FlagErrorFormat, "error-format", "", errorFormatText,
+		"Format of the error a failing run reports on stderr: text (a log record) or json")
+	completeFlag(fs, "log-format", completeChoice, logFormats...)
+	completeFlag(fs, "log-level", completeChoice, logLevelNames...)
+	completeFlag(fs, "log-file", completeFile)
+	completeFlag(fs, "error-format", completeChoice, errorFormats...)
+}
 
-// closeLog shuts the logging service down
-// cleanly, flushing buffers (and thus
-// preserving the most likely error of
-// interest)
-func closeLog() {
+// newLogHandler returns a handler writing records to w in the given
+// format (see logFormats), at the level of xLogLevel. Records carry the
+// file and line they were logged from.
+func newLogHandler(w io.Writer, format string) slog.Handler {
+	opts := &slog.HandlerOptions{
+		AddSource:   true,
+		Level:       xLogLevel,
+		ReplaceAttr: shortSource,
+	}
+	if logFormatJSON == format {
+		return slog.NewJSONHandler(w, opts)
+	}
+	return slog.NewTextHandler(w, opts)
+}
 
-	var err error = nil
+// shortSource replaces the source of a record, which slog gives as the
+// function, full path and line, with file:line.
+func shortSource(groups []string, a slog.Attr) slog.Attr {
+	if 0 < len(groups) || slog.SourceKey != a.Key {
+		return a
+	}
+	src, ok := a.Value.Any().(*slog.Source)
+	if ok && nil != src {
+		return slog.String(slog.SourceKey, fmt.Sprintf("%s:%d", filepath.Base(src.File), src.Line))
+	}
+	return a
+}
 
-	closeLogMutex.Lock()
-	{
-		if nil != xLogBuffer {
-			flushCloseLog()
-			xLogBuffer = nil
+// logLevel returns the level --log-level, --debug and --verbose ask
+// for. An explicit --log-level wins over the other two.
+func logLevel() (level slog.Level, err error) {
+	switch {
+	case "" != FlagLogLevel:
+		err = level.UnmarshalText([]byte(FlagLogLevel))
+		if nil != err || !slices.Contains(logLevelNames, strings.ToLower(FlagLogLevel)) {
+			return level, fmt.Errorf("--log-level must be one of %s, not %q",
+				strings.Join(logLevelNames, ", "), FlagLogLevel)
 		}
+		return level, nil
+	case FlagDebug:
+		return slog.LevelDebug, nil
+	case FlagVerbose:
+		return slog.LevelInfo, nil
+	}
+	return slog.LevelWarn, nil
+}
 
-		if nil != xLogFile {
-			err = xLogFile.Close()
+// configureLog sets xLog up from the logging flags, once they are
+// parsed: the level, the format, and the destinations, which are
+// stderr (unless --quiet) and the log file (see logFilePath). Bad
+// values are an ErrUsage, and leave the log as it was. If the log file
+// cannot be opened, the log goes to stderr only.
+func configureLog() error {
+	level, err := logLevel()
+	if nil != err {
+		return usageErrorf("%w", err)
+	}
+	if !slices.Contains(logFormats, FlagLogFormat) {
+		return usageErrorf("--log-format must be text or json, not %q", FlagLogFormat)
+	}
+	if !slices.Contains(errorFormats, FlagErrorFormat) {
+		return usageErrorf("--error-format must be text or json, not %q", FlagErrorFormat)
+	}
+
+	closeLog()
+	var logWriters = make([]io.Writer, 0, 2)
+	if !FlagQuiet {
+		logWriters = append(logWriters, os.Stderr)
+	}
+	path := logFilePath()
+	if "" != path {
+		xLogFile, err = openRotatingFile(path, logMaxSize, logKeep)
+		if nil != err {
+			xLog.Warn("Could not open the log file", "file", path, "err", err)
 			xLogFile = nil
+		} else {
+			logWriters = append(logWriters, xLogFile)
 		}
 	}
-	closeLogMutex.Unlock()
 
+	xLogLevel.Set(level)
+	xLog = slog.New(newLogHandler(io.MultiWriter(logWriters...), FlagLogFormat))
+	return nil
+}
+
+// logFilePath returns the file --log-file names, or, by default,
+// $XDG_STATE_HOME/madcolor/madcolor.log (where XDG_STATE_HOME defaults to
+// ~/.local/state), creating the directory. It returns "" for
+// --log-file none, or if there is no home directory to put the default
+// in.
+func logFilePath() string {
+	if logFileNone == FlagLogFile {
+		return ""
+	}
+	if "" != FlagLogFile {
+		return FlagLogFile
+	}
+	dir := os.Getenv("XDG_STATE_HOME")
+	if "" == dir {
+		home, err := os.UserHomeDir()
+		if nil != err {
+			return ""
+		}
+		dir = filepath.Join(home, ".local", "state")
+	}
+	dir = filepath.Join(dir, "madcolor")
+	err := os.MkdirAll(dir, 0o700)
 	if nil != err {
-		safeLogPrintf(err.Error())
+		xLog.Warn("Could not create the log directory", "dir", dir, "err", err)
+		return ""
 	}
+	return filepath.Join(dir, logFileName)
 }
 
-// initLog initializes the log file and log buffer.
-// It opens the log file with the specified name, creating it if it does not exist, and truncates it if it does exist.
-// If opening the log file encounters an error, it logs the error message to standard output using safeLogPrintf.
-// It creates a new bufio.Writer to be used as the log buffer and sets the log writers to the standard output and the log buffer.
-// It sets the log flags to include the date, time, UTC, and short file.
-// It resolves the absolute path of the log file and logs it using safeLogPrintf.
-// This function is typically called at the initialization of the logging service.
-// The log file name should be passed as the lfName argument.
-func initLog(lfName string) {
-	var err error
-	var logWriters = make([]io.Writer, 0, 2)
-	xLogFile, err = os.OpenFile(lfName, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0666)
+// rotatingFile is a log file that is appended to, and rotated when
+// the next record would take it past maxSize: path.1 holds the previous
+// log, path.2 the one before, and so on up to path.<keep>, and older
+// logs are removed. slog writes each record with a single Write, so a
+// record is never split between two files.
+type rotatingFile struct {
+	path    string
+	maxSize int64
+	keep    int
+	file    *os.File
+	size    int64
+}
+
+// openRotatingFile opens path for appending, creating it if need be.
+func openRotatingFile(path string, maxSize int64, keep int) (*rotatingFile, error) {
+	rf := &rotatingFile{path: path, maxSize: maxSize, keep: keep}
+	return rf, rf.open()
+}
+
+// open opens rf.path and records its size.
+func (rf *rotatingFile) open() error {
+	f, err := os.OpenFile(rf.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o600)
+	if nil != err {
+		return err
+	}
+	info, err := f.Stat()
 	if nil != err {
-		safeLogPrintf("error opening log file %s because %s",
-			lfName, err.Error())
+		_ = f.Close()
+		return err
 	}
+	rf.file, rf.size = f, info.Size()
+	return nil
+}
 
-	xLogBuffer = bufio.NewWriter(xLogFile)
-	logWriters = append(logWriters, os.Stdout)
-	logWriters = append(logWriters, xLogBuffer)
-	xLog.SetFlags(log.Ldate | log.Ltime | log.LUTC | log.Lshortfile)
-	xLog.SetOutput(io.MultiWriter(logWriters...))
+// Write appends p, rotating the file first if p would take it past
+// maxSize. A record larger than maxSize is still written, to a fresh
+// file.
+func (rf *rotatingFile) Write(p []byte) (n int, err error) {
+	if 0 < rf.size && rf.maxSize < rf.size+int64(len(p)) {
+		err = rf.rotate()
+		if nil != err {
+			return 0, err
+		}
+	}
+	n, err = rf.file.Write(p)
+	rf.size += int64(n)
+	return n, err
+}
 
-	// logPath, err := filepath.Abs(xLogFile.Name())
+// rotate closes the file, shifts path.1 ... path.<keep-1> up by one
+// (dropping path.<keep>), renames the file to path.1 and opens a new
+// one.
+func (rf *rotatingFile) rotate() error {
+	err := rf.file.Close()
+	if nil != err {
+		return err
+	}
+	for i := rf.keep - 1; 0 < i; i-- {
+		// a missing older log is not an error
+		_ = os.Rename(fmt.Sprintf("%s.%d", rf.path, i), fmt.Sprintf("%s.%d", rf.path, i+1))
+	}
+	err = os.Rename(rf.path, rf.path+".1")
 	if nil != err {
-		safeLogPrintf("huh? could not resolve logfilename %s because %s",
-			xLogFile.Name(), err.Error())
-		myFatal()
+		return err
 	}
-	// safeLogPrintf("Logfile set to %s", logPath)
+	return rf.open()
+}
 
+// Close closes the file.
+func (rf *rotatingFile) Close() error {
+	return rf.file.Close()
+}
+
+// fileLog returns a logger that writes to the log file only, or nil
+// when there is none.
+func fileLog() *slog.Logger {
+	if nil == xLogFile {
+		return nil
+	}
+	return slog.New(newLogHandler(xLogFile, FlagLogFormat))
+}
+
+var closeLogMutex sync.Mutex
+
+// closeLog shuts the log file down cleanly. Afterwards xLog writes to
+// stderr, so that late messages are not lost.
+func closeLog() {
+
+	var err error = nil
+
+	closeLogMutex.Lock()
+	{
+		if nil != xLogFile {
+			xLog = slog.New(newLogHandler(os.Stderr, FlagLogFormat))
+			err = xLogFile.Close()
+			xLogFile = nil
+		}
+	}
+	closeLogMutex.Unlock()
+
+	if nil != err {
+		xLog.Error("Could not close the log file", "err", err)
+	}
 }
 
 var myFatalMutex sync.Mutex
 
 // myFatal is meant to close the program, and close the
-// log files properly. Go doesn't support optional arguments,
+// log files properly. Only main calls it, with the exit code
+// for the error a command returned (see exitCode); everything
+// below returns errors instead. Go doesn't support optional arguments,
 // but variadic arguments allow finessing this. myFatal() gets
-// a default RC of -1, and that's overridden by the first int
+// a default RC of exitError, and that's overridden by the first int
 // in the slice of integers argument (which is present
 // even if the length is 0).
 //
@@ -103,7 +314,7 @@ var myFatalMutex sync.Mutex
 // thorough at-close routine and register closing the file
 // and log as part of the things to do 'at close'.
 func myFatal(rcList ...int) {
-	var rc int = -1
+	var rc int = exitError
 	myFatalMutex.Lock()
 	// the app never releases this lock, because the
 	// app is exiting for an fatal error. Only one
@@ -111,49 +322,26 @@ func myFatal(rcList ...int) {
 	// any threads waiting on this lock are similarly
 	// in a fatal condition.
 
-	// default rc is -1, but that *might* be
+	// default rc is exitError, but that *might* be
 	// overridden by the caller
 	if len(rcList) > 0 {
 		rc = rcList[0]
 	}
 
-	// if this is an expected exit
-	// this doesn't need to be logged
-	if rc != 0 || FlagDebug {
+	// the caller has already logged why; where from is only of
+	// interest when debugging
+	if xLog.Enabled(context.Background(), slog.LevelDebug) {
 		_, srcFile, srcLine, ok := runtime.Caller(1)
 		if ok {
-			srcFile = filepath.Base(srcFile)
-			safeLogPrintf("\n\t\t/*** myFatal called ***/\n"+
-				"\tfrom file:line %12s:%04d\n"+
-				"\t\t/*** myFatal ended ***/", srcFile, srcLine)
+			xLog.Debug("exiting", "code", rc, "from", fmt.Sprintf("%s:%d", filepath.Base(srcFile), srcLine))
 		} else {
-			safeLogPrintf("\n\t\t/*** myFatal called ***/\n" +
-				"\tbut could not get stack information for caller\n" +
-				"\t\t/*** myFatal ended ***/")
+			xLog.Debug("exiting", "code", rc)
 		}
 	}
 	closeLog()
 	os.Exit(rc)
 }
 
-// safeLogPrintf may be called in lieu of xLog.Printf() if there
-// is a possibility the log may not be open. If the log is
-// available, well and good. Otherwise, print the message to
-// STDERR.
-var safeLogPrintfMutex sync.Mutex
-
-func safeLogPrintf(format string, a ...any) {
-	safeLogPrintfMutex.Lock()
-	defer safeLogPrintfMutex.Unlock()
-	if nil != xLogBuffer && nil != xLogFile {
-		xLog.Printf(format, a...)
-	} else {
-		_, _ = fmt.Fprintf(os.Stderr,
-			"\n\tSAFELOG\n"+format+"\n",
-			a...)
-	}
-}
-
 // debugMapStringString is a function that takes a map of string keys and string values as input.
 // It generates a formatted string representation of the map for debugging purposes.
 // The output string includes the size of the map and all key-value pairs in a tabular format.
@@ -194,8 +382,7 @@ func (w *OTWriter) WriteString(s ...string) {
 	for _, str := range s {
 		_, err := w.writer.WriteString(str)
 		if nil != err {
-			xLog.Printf("failed to write %s to bufio.writer because %s",
-				str, err.Error())
+			xLog.Error("Could not write the output", "text", str, "err", err)
 		}
 	}
 }
@@ -203,8 +390,7 @@ func (w *OTWriter) Write(s ...[]byte) {
 	for _, str := range s {
 		_, err := w.writer.Write(str)
 		if nil != err {
-			xLog.Printf("failed to write %s to bufio.writer because %s",
-				string(str), err.Error())
+			xLog.Error("Could not write the output", "text", string(str), "err", err)
 		}
 	}
 }
@@ -212,8 +398,7 @@ func (w *OTWriter) WriteRune(s ...rune) {
 	for _, str := range s {
 		_, err := w.writer.WriteRune(str)
 		if nil != err {
-			xLog.Printf("failed to write %s to bufio.writer because %s",
-				string(str), err.Error())
+			xLog.Error("Could not write the output", "text", string(str), "err", err)
 		}
 	}
 }
@@ -3,12 +3,16 @@ package main
 import (
 	"bufio"
 	"bytes"
+	"context"
+	"errors"
+	"fmt"
 	"io"
+	"log/slog"
 	"os"
 	"path"
 	"strings"
+	"unicode"
 
-	"golang.design/x/clipboard"
 	htmlColor "madcolor/htmlcolor"
 	"madcolor/misc"
 )
@@ -22,48 +26,103 @@ const DEBUGTEXTLOG = "madcolorDebugText.log"
 var minContrast = 60
 var minColorDistance = 33
 
+// initializeClipboard selects the clipboard backend (see selectClipboard)
+// and records whether a clipboard is available at all. It is called by
+// initFlags, since --clipboard-backend chooses the backend. If the user
+// asked for --buff and there is no clipboard, say so rather than
+// quietly colorizing the default text.
 func initializeClipboard() {
-	err := clipboard.Init()
+	var err error
+	clipBackend, err = selectClipboard(FlagClipboardBackend)
 	if nil == err {
 		modeClipboardAvailable = true
+		xLog.Info("using the clipboard", "clipboard", clipBackend.Name())
+		if FlagPasteHTML && nFlags.Changed("paste-html") && !holdsHTML(clipBackend) {
+			xLog.Warn("this clipboard holds one flavor only, --paste-html pastes the text alone",
+				"clipboard", clipBackend.Name())
+		}
 	} else {
 		modeClipboardAvailable = false
-		if FlagDebug || FlagVerbose {
-			xLog.Printf("Clipboard not available because %s", err.Error())
+		level := slog.LevelInfo
+		if (FlagClipboardBuffer && nFlags.Changed("buff")) || nFlags.Changed("clipboard-backend") {
+			level = slog.LevelWarn
 		}
+		xLog.Log(context.Background(), level, "the clipboard is not available",
+			"clipboard", FlagClipboardBackend, "err", err)
 	}
 }
 
-// main runs the colorize program.
-// It initializes the log file, closes it when done,
-// initializes the command line flags, initializes and
+// main runs the program: it runs the command named by the first
+// argument (see runCommand), which is colorize unless another command is
+// given, reports the error it returns (see reportError), and exits with
+// the code for it (see exitCode), closing the log file (see
+// configureLog) on the way out.
+func main() {
+	err := runCommand(os.Args[1:])
+	if nil != err && !errors.Is(err, errHelp) {
+		reportError(os.Stderr, err, errorFormat(os.Args[1:]))
+	}
+	if rc := exitCode(err); exitOK != rc {
+		myFatal(rc)
+	}
+	closeLog()
+}
+
+// reportError reports the error a command returned: as a log record,
+// or with --error-format json, as an errorReport on w (stderr), in
+// which case the record only goes to the log file.
+func reportError(w io.Writer, err error, format string) {
+	if errorFormatJSON != format {
+		xLog.Error(err.Error())
+		return
+	}
+	if wErr := writeErrorJSON(w, err); nil != wErr {
+		xLog.Error(err.Error())
+		return
+	}
+	if fLog := fileLog(); nil != fLog {
+		fLog.Error(err.Error())
+	}
+}
+
+// runColorize runs the colorize command.
+// It initializes the command line flags, initializes and
 // opens the input source, initializes and opens the output
 // destination, initializes the HTML color names, and
 // colorizes the input using the colorize function.
-func main() {
-	var err error
+func runColorize(args []string) error {
 	var writerList []io.Writer
 	var clipboardBuffer bytes.Buffer
 
 	// SETUP *****************************
 
-	initLog("madcolor.log")
-	defer closeLog()
-
-	initializeClipboard()
-
-	initFlags()
+	err := initFlags(args)
+	if nil != err {
+		return err
+	}
 
-	misc.SetOptions(FlagDebug, FlagVerbose, &xLog, myFatal)
+	misc.SetOptions(FlagDebug, FlagVerbose, xLog)
 
 	if !FlagInventColor && !nFlags.Changed("contrast") {
 		FlagContrast += 10
 	}
 
-	br := getInput()
-	f := getOutput()
+	if FlagWatchClipboard {
+		return runWatch()
+	}
 
-	defer misc.DeferError(f.Close)
+	br, err := getInput()
+	if nil != err {
+		return err
+	}
+	f, err := getOutput()
+	if nil != err {
+		return err
+	}
+
+	if os.Stdout != f {
+		defer misc.DeferError(f.Close)
+	}
 	writerList = append(writerList, f)
 
 	if FlagClip {
@@ -72,161 +131,326 @@ func main() {
 	}
 
 	if FlagDebug {
-		f, err := os.Open(DEBUGTEXTLOG)
+		f, err := os.OpenFile(DEBUGTEXTLOG, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0666)
 		if err != nil {
-			xLog.Printf("could not open %s because: %s", DEBUGTEXTLOG, err)
-			myFatal()
+			return fmt.Errorf("%w %s: %w", ErrOutput, DEBUGTEXTLOG, err)
 		}
 		defer misc.DeferError(f.Close)
 		writerList = append(writerList, f)
 	}
 
 	mw := bufio.NewWriter(io.MultiWriter(writerList...))
-	colorize(br, mw)
+	err = colorize(br, mw)
+	if nil != err {
+		return err
+	}
 	err = mw.Flush()
 	if nil != err {
-		xLog.Printf("huh? Could not flush bytes from buffered multiwriter because %s",
-			err.Error())
-		myFatal()
+		return fmt.Errorf("%w: %w", ErrOutput, err)
 	}
 
 	if FlagClip {
-		_ = clipboard.Write(clipboard.FmtText, clipboardBuffer.Bytes())
+		var html []byte
+		if FlagPasteHTML && formatHTML == FlagFormat {
+			html = clipboardBuffer.Bytes()
+		}
+		err = clipBackend.Write(clipboardBuffer.Bytes(), html)
+		if nil != err {
+			xLog.Error("Could not write to the clipboard",
+				"clipboard", clipBackend.Name(), "err", err)
+		}
 	}
-
+	return nil
 }
 
 // getOutput returns a *os.File that represents the output destination.
 // If the `FlagOutput` variable is set, `getOutput` creates a file with
 // the specified // name in the directory specified by `FlagOutputDir`
-// and returns it. If opening the file encounters an error, it logs the
-// error message using `xLog.Printf` and calls `myFatal` to exit the
-// program. If the `FlagOutput` variable is not set, `getOutput` returns
+// and returns it. If the file cannot be created, the error wraps
+// ErrOutput. If the `FlagOutput` variable is not set, `getOutput` returns
 // `os.Stdout`. It does not return a buffered writer, because there would be
 // no way to close the underlying file.
-func getOutput() (f *os.File) {
+func getOutput() (f *os.File, err error) {
 	var fn string
-	var err error
 
 	if FlagPipe {
-		return os.Stdout
+		return os.Stdout, nil
 	}
 
 	if misc.IsStringSet(&FlagOutput) {
 		fn = path.Join(FlagOutputDir, FlagOutput)
 		f, err = os.Create(fn)
 		if err != nil {
-			xLog.Printf("Could not open file %s because %s", fn, err.Error())
-			myFatal()
+			return nil, fmt.Errorf("%w %s: %w", ErrOutput, fn, err)
 		}
 	} else {
 		f = os.Stdout
 	}
-	return f
+	return f, nil
 }
 
-func getInput() (br *bufio.Reader) {
+// getInput returns a reader of the input: stdin for --pipe, the --input
+// file, the clipboard for --buff, or else the --text. If the --input file
+// cannot be opened, the error wraps ErrInput.
+func getInput() (br *bufio.Reader, err error) {
 	if FlagPipe {
-		return bufio.NewReader(os.Stdin)
+		return bufio.NewReader(os.Stdin), nil
 	}
 
 	if misc.IsStringSet(&FlagInput) {
 		f, err := os.Open(FlagInput)
 		if err != nil {
-			xLog.Printf("Could not open %s because %s", FlagInput, err.Error())
-			myFatal()
+			return nil, fmt.Errorf("%w %s: %w", ErrInput, FlagInput, err)
 		}
-		return bufio.NewReader(f)
+		return bufio.NewReader(f), nil
 	}
 
-	if FlagClipboardBuffer {
-		b := clipboard.Read(clipboard.FmtText)
-		nr := bytes.NewReader(b)
-		return bufio.NewReader(nr)
+	if FlagClipboardBuffer && modeClipboardAvailable {
+		b, err := readClipboard(clipBackend)
+		if nil == err {
+			nr := bytes.NewReader(b)
+			return bufio.NewReader(nr), nil
+		}
+		xLog.Warn("Could not read the clipboard, colorizing --text instead",
+			"clipboard", clipBackend.Name(), "err", err)
 	}
 
-	return bufio.NewReader(strings.NewReader(FlagText))
+	return bufio.NewReader(strings.NewReader(FlagText)), nil
 }
 
-// colorize applies color to each character read from the input.
-// It calculates the background color if FlagBackgroundColor is not set,
-// using a random color or a random named color from the htmlColor package.
-// It then generates a foreground color that has enough contrast and distance
-// from the background color.
-// If FlagAntiColor is set, it generates a random background color for each character.
-// It writes the colorized text to the output using the OTWriter type.
-// It wraps the colorized text in `<div>` tags.
+// colorize applies color to each unit read from the input and writes
+// the result to out, with the options set on the command line.
+// Color selection is done by colorRuns; the markup is written by
+// renderRuns according to --format and --css.
 //
 // Parameters:
 // - in: Input reader for reading characters
 // - out: Output writer for writing colorized text
 //
+// Returns: the error of colorRuns, or one wrapping ErrOutput if the
+// stylesheet could not be written
+func colorize(in *bufio.Reader, out *bufio.Writer) error {
+	opts := optionsFromFlags()
+	runs, _, err := colorRuns(in, opts)
+	if nil != err {
+		return err
+	}
+	sheet := renderRuns(runs, out, opts)
+	if misc.IsStringSet(&sheet) {
+		err = os.WriteFile(opts.CSSFile, []byte(sheet), 0666)
+		if nil != err {
+			return fmt.Errorf("%w %s: %w", ErrOutput, opts.CSSFile, err)
+		}
+	}
+	return nil
+}
+
+// colorizeText colorizes text with opts and returns the output, the
+// runs it is made of and the background the colors were chosen against.
+// It is the pipeline shared by the server and RPC modes; a stylesheet
+// is always written inline. The error is that of colorRuns.
+func colorizeText(text string, opts colorOptions) (out string, runs []coloredRun, bg string, err error) {
+	var buf bytes.Buffer
+	opts.CSSFile = ""
+	bw := bufio.NewWriter(&buf)
+	runs, bg, err = colorRuns(bufio.NewReader(strings.NewReader(text)), opts)
+	if nil != err {
+		return "", nil, "", err
+	}
+	renderRuns(runs, bw, opts)
+	misc.DeferError(bw.Flush)
+	return buf.String(), runs, bg, nil
+}
+
+// colorRuns reads the input a unit at a time and selects the colors for each.
+// It calculates the background color if opts.Background is not set,
+// using a random color or a random named color from the htmlColor package.
+// It then generates a foreground color that has enough contrast and distance
+// from the background color, either randomly or (with --mode hash) from a
+// hash of each unit, so the same glyph or word always gets the same color.
+// If opts.Anti is set, it generates a random background color for each unit.
+// The colors of each unit are selected by pickColors.
+//
 // Variables:
-// - r: Represents each character read from the input (rune type)
+// - unit: Each glyph or word read from the input (see --unit)
 // - err: Error returned from reading characters from the input
-// - fg: Foreground color string
 // - bg: Background color string
-// - w: Writer that writes the colorized text to the output
-// - colorName: Name of the generated foreground color
+// - colorName: Name of the generated background color
 //
-// Returns: None
-func colorize(in *bufio.Reader, out *bufio.Writer) {
-	var r rune
-	var err error = nil
-	var w = NewOTWriter(out)
-	var fg, bg, colorName string
-
-	bg = FlagBackgroundColor
-
-	// figure out the background color if not FlagAnticolor
-	if !FlagAntiColor && !misc.IsStringSet(&FlagBackgroundColor) {
-		err = nil
-		if FlagInventColor {
+// Returns: the colored runs, in input order, and the background color
+// (which is "" for opts.Anti, as each run has its own), or the first
+// error of pickColors, or one wrapping ErrInput if the input could not
+// be read
+func colorRuns(in *bufio.Reader, opts colorOptions) (runs []coloredRun, background string, err error) {
+	var unit string
+	var bg, colorName string
+
+	bg = opts.Background
+
+	// figure out the background color if not opts.Anti
+	if !opts.Anti && !misc.IsStringSet(&opts.Background) {
+		if opts.Invent {
 			bg = htmlColor.RandColor()
-			err = nFlags.Set("background-color", bg)
 		} else {
-			_, colorName, bg = htmlColor.RandNamedColor()
-			err = nFlags.Set("background-color", colorName)
+			_, colorName, bg, err = htmlColor.RandNamedColor()
+			if nil != err {
+				return nil, "", err
+			}
+		}
+		xLog.Warn("the background color was unset; picked one",
+			"background", bg, "name", colorName)
+	}
+	if !opts.Anti {
+		background, err = htmlColor.ParseColor(bg)
+		if nil == err {
+			err = checkSatisfiable(background, &opts)
 		}
-		xLog.Printf("warning: FlagBackgroundColor was unset. "+
-			"Should not happen! Creating a default background color ... %s %s",
-			bg, colorName)
 		if nil != err {
-			xLog.Printf("Failed to set background-color color flag because %s", err.Error())
-			myFatal()
+			return nil, "", err
 		}
 	}
 
-	w.WriteString("<span>")
-
-	for r, _, err = in.ReadRune(); err == nil; r, _, err = in.ReadRune() {
-
-		w.WriteString("<span style=\"color: ")
+	for unit, err = readUnit(in, opts.Unit); err == nil; unit, err = readUnit(in, opts.Unit) {
+		run := coloredRun{text: unit}
+		run.fgName, run.fg, run.bgName, run.bg, err = pickColors(unit, bg, opts)
+		if nil != err {
+			return nil, "", err
+		}
+		runs = append(runs, run)
+	}
+	if io.EOF != err {
+		return nil, "", fmt.Errorf("%w: %w", ErrInput, err)
+	}
+	return runs, background, nil
+}
 
-		if FlagAntiColor {
-			if FlagInventColor {
-				bg = htmlColor.RandColor()
-			} else {
-				_, _, bg = htmlColor.RandNamedColor()
+// pickColors selects the colors for one unit of text against the
+// background bg: the foreground, randomly or (with --mode hash) from a
+// hash of the unit, and with opts.Anti, a background of the unit's own
+// (otherwise bg and bgName are ""). It is shared by colorRuns and
+// recolor. When no color meets the contrast and distance, it acts on
+// opts.OnUnsatisfiable: fail returns the
+// htmlColor.ErrContrastUnsatisfiable, relax lowers the contrast and
+// distance relaxStep points at a time until a color meets them, and
+// best-effort uses the fallback htmlcolor picks (black or white). Other
+// errors, such as an unknown bg, are returned.
+func pickColors(unit string, bg string, opts colorOptions) (fgName, fg, bgName, ownBg string, err error) {
+	if opts.Anti {
+		switch {
+		case opts.Mode == modeHash:
+			bgName, bg = htmlColor.HashBackground(unit, opts.Salt, opts.Invent)
+		case opts.Invent:
+			bgName, bg = "", htmlColor.RandColor()
+		default:
+			_, bgName, bg, err = htmlColor.RandNamedColor()
+			if nil != err {
+				return "", "", "", "", err
 			}
 		}
+		ownBg = bg
+	}
 
-		if FlagInventColor {
-			fg, _ = htmlColor.InventColor(bg, minContrast, minColorDistance)
-		} else {
-			colorName, fg = htmlColor.RandomColor(bg, minContrast, minColorDistance)
+	fgName, fg, err = pickForeground(unit, bg, opts)
+	if errors.Is(err, htmlColor.ErrContrastUnsatisfiable) {
+		switch opts.OnUnsatisfiable {
+		case unsatFail:
+			return "", "", "", "", err
+		case unsatRelax:
+			relaxed := opts
+			for errors.Is(err, htmlColor.ErrContrastUnsatisfiable) &&
+				(0 < relaxed.Contrast || 0 < relaxed.Distance) {
+				relaxed.Contrast = max(0, relaxed.Contrast-relaxStep)
+				relaxed.Distance = max(0, relaxed.Distance-relaxStep)
+				fgName, fg, err = pickForeground(unit, bg, relaxed)
+			}
+			xLog.Debug("relaxed the contrast and distance", "unit", unit, "bg", bg,
+				"contrast", relaxed.Contrast, "distance", relaxed.Distance)
+		default:
+			xLog.Debug("no color meets the contrast; using the fallback", "unit", unit, "fg", fg, "err", err)
+			err = nil
 		}
+	}
+	if nil != err {
+		return "", "", "", "", err
+	}
+	xLog.Debug("picked colors", "unit", unit, "fg", fg, "fg_name", fgName, "bg", bg, "bg_name", bgName)
+	return fgName, fg, bgName, ownBg, nil
+}
 
-		w.WriteString(fg)
+// pickForeground selects the foreground for one unit of text against
+// bg, randomly or (with --mode hash) from a hash of the unit, with the
+// errors of htmlcolor.
+func pickForeground(unit string, bg string, opts colorOptions) (fgName, fg string, err error) {
+	switch {
+	case opts.Mode == modeHash:
+		fgName, fg, err = htmlColor.HashColor(unit, opts.Salt, bg,
+			opts.Contrast, opts.Distance, opts.Invent)
+	case opts.Invent:
+		fg, _, err = htmlColor.InventColor(bg, opts.Contrast, opts.Distance)
+	default:
+		fgName, fg, err = htmlColor.RandomColor(bg, opts.Contrast, opts.Distance)
+	}
+	return fgName, fg, err
+}
 
-		if FlagAntiColor {
-			w.WriteString("; padding: 0px 0px 1px 0px; background-color: ")
-			w.WriteString(bg)
+// checkSatisfiable says up front when no color can meet the contrast
+// and distance of opts against bg (see htmlColor.CheckSatisfiable),
+// acting on opts.OnUnsatisfiable: fail returns the error, relax lowers
+// the contrast and distance of opts to the most bg allows, and
+// best-effort keeps them, so that pickColors falls back to black or
+// white. Either way, the most that is achievable is logged.
+func checkSatisfiable(bg string, opts *colorOptions) error {
+	err := htmlColor.CheckSatisfiable(bg, opts.Contrast, opts.Distance)
+	if !errors.Is(err, htmlColor.ErrContrastUnsatisfiable) {
+		return err
+	}
+	switch opts.OnUnsatisfiable {
+	case unsatFail:
+		return err
+	case unsatRelax:
+		contrast, distance, aErr := htmlColor.Achievable(bg)
+		if nil != aErr {
+			return aErr
 		}
+		opts.Contrast = min(opts.Contrast, int(contrast))
+		opts.Distance = min(opts.Distance, int(distance))
+		xLog.Warn("relaxed the contrast and distance to what the background allows",
+			"contrast", opts.Contrast, "distance", opts.Distance, "err", err)
+	default:
+		xLog.Warn("no color meets the contrast and distance; using the best there is", "err", err)
+	}
+	return nil
+}
+
+// readUnit reads the next unit of text that receives a single color.
+// For unitGlyph, this is one rune. For unitWord, this is a maximal run
+// of non-space runes, or a maximal run of space runes, so that words and
+// the whitespace between them alternate and no text is dropped.
+// The error is that of the underlying reader (io.EOF at end of input).
+func readUnit(in *bufio.Reader, unit string) (s string, err error) {
+	var sb strings.Builder
+
+	r, _, err := in.ReadRune()
+	if nil != err {
+		return "", err
+	}
+	sb.WriteRune(r)
 
-		w.WriteString(";\">")
-		w.WriteRune(r)
-		w.WriteString("</span>")
+	if unit != unitWord {
+		return sb.String(), nil
+	}
+
+	space := unicode.IsSpace(r)
+	for {
+		r, _, err = in.ReadRune()
+		if nil != err {
+			// the unit is complete; report the error on the next call
+			return sb.String(), nil
+		}
+		if unicode.IsSpace(r) != space {
+			_ = in.UnreadRune()
+			return sb.String(), nil
+		}
+		sb.WriteRune(r)
 	}
-	w.WriteString("</span>\n")
 }
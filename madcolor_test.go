@@ -0,0 +1,87 @@
+package main
+
+import (
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// TestColorizeConstraints checks that --contrast and --distance reach
+// color selection: every color used meets both against the background,
+// in each selection mode.
+func TestColorizeConstraints(t *testing.T) {
+	rxColor := regexp.MustCompile(`color: (#[0-9a-f]{6})`)
+	for _, mode := range [][]string{
+		{"--mode", modeRandom},
+		{"--mode", modeHash},
+		{"--mode", modeRandom, "--invent"},
+	} {
+		dir := isolate(t)
+		args := append([]string{"colorize", "--text", "The quick brown fox jumps over the lazy dog",
+			"--buff=false", "--nopaste=false", "--background-color", "navy",
+			"--contrast", "60", "--distance", "40", "--on-unsatisfiable", "fail",
+			"--output-dir", dir, "--output", "colored"}, mode...)
+		err := run(t, args...)
+		if nil != err {
+			t.Fatalf("%v: %v", mode, err)
+		}
+
+		colors := rxColor.FindAllStringSubmatch(readFile(t, filepath.Join(dir, "colored")), -1)
+		if 0 == len(colors) {
+			t.Fatalf("%v: no colors in the output", mode)
+		}
+		for _, m := range colors {
+			resp, err := contrastResult(m[1], "navy")
+			if nil != err {
+				t.Fatal(err)
+			}
+			if resp.Contrast < 0.60 || resp.Distance < 0.40*3*0xFF {
+				t.Errorf("%v: %s has contrast %.2f and distance %.0f", mode, m[1], resp.Contrast, resp.Distance)
+			}
+		}
+	}
+}
+
+// TestFormatANSI checks --format ansi: a 24-bit escape sequence per
+// unit, with --anti a background one too, and no markup even where
+// --css or --annotate would add some.
+func TestFormatANSI(t *testing.T) {
+	cases := []struct {
+		args []string
+		want string
+	}{
+		{nil, "\x1b[38;2;"},
+		{[]string{"--anti"}, "\x1b[48;2;"},
+		{[]string{"--css", "classes", "--annotate"}, "\x1b[38;2;"},
+	}
+	for _, c := range cases {
+		dir := isolate(t)
+		args := append([]string{"colorize", "--text", "a<b", "--buff=false", "--nopaste=false",
+			"--format", "ansi", "--output-dir", dir, "--output", "colored"}, c.args...)
+		err := run(t, args...)
+		if nil != err {
+			t.Fatalf("%v: %v", c.args, err)
+		}
+		out := readFile(t, filepath.Join(dir, "colored"))
+		if 3 > strings.Count(out, c.want) || strings.Contains(out, "<span") ||
+			strings.Contains(out, "&lt;") {
+			t.Errorf("%v gave %q", c.args, out)
+		}
+	}
+}
+
+// TestDebugCopy checks that --debug writes a copy of the output to
+// DEBUGTEXTLOG in the current directory, creating it.
+func TestDebugCopy(t *testing.T) {
+	dir := isolate(t)
+	chdir(t, dir)
+	err := run(t, "colorize", "--text", "debug", "--buff=false", "--nopaste=false", "--debug",
+		"--output-dir", dir, "--output", "colored")
+	if nil != err {
+		t.Fatalf("colorize --debug: %v", err)
+	}
+	if colored := readFile(t, filepath.Join(dir, "colored")); colored != readFile(t, DEBUGTEXTLOG) {
+		t.Errorf("%s does not hold the output", DEBUGTEXTLOG)
+	}
+}
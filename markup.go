@@ -0,0 +1,498 @@
+package main
+
+import (
+	"fmt"
+	"html"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	htmlColor "madcolor/htmlcolor"
+)
+
+// blockTags end a line when they close (or, for br, when they appear)
+// when HTML is reduced to its text.
+var blockTags = map[string]bool{
+	"br": true, "p": true, "div": true, "li": true, "tr": true,
+	"h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+	"pre": true, "blockquote": true,
+}
+
+// voidTags are the elements that have no content and no closing tag.
+var voidTags = map[string]bool{
+	"area": true, "base": true, "br": true, "col": true, "embed": true,
+	"hr": true, "img": true, "input": true, "link": true, "meta": true,
+	"source": true, "track": true, "wbr": true,
+}
+
+// markup token kinds
+const (
+	tokText  = iota // text between tags, still entity-encoded
+	tokTag          // an opening or closing tag
+	tokOther        // a comment, or the content of <style>, <script> or <head>
+)
+
+// markupToken is one piece of an HTML fragment. The tokens of a
+// fragment cover it exactly, so it can be rewritten token by token.
+type markupToken struct {
+	kind    int
+	start   int    // byte offset of the token in the fragment
+	end     int    // byte offset just past the token
+	name    string // lowercase element name, for tokTag
+	closing bool   // a closing tag, for tokTag
+}
+
+// tokenizeMarkup splits an HTML fragment into text, tags, comments and
+// the raw content of <style>, <script> and <head>. It is forgiving the
+// way browsers are: a '<' that does not start a tag is text, and an
+// unterminated comment runs to the end.
+func tokenizeMarkup(markup string) (toks []markupToken) {
+	textStart := 0
+	addText := func(end int) {
+		if end > textStart {
+			toks = append(toks, markupToken{kind: tokText, start: textStart, end: end})
+		}
+	}
+
+	for ix := 0; ix < len(markup); {
+		if markup[ix] != '<' {
+			ix++
+			continue
+		}
+		if strings.HasPrefix(markup[ix:], "<!--") {
+			addText(ix)
+			end := strings.Index(markup[ix+4:], "-->")
+			if end < 0 {
+				end = len(markup)
+			} else {
+				end = ix + 4 + end + 3
+			}
+			toks = append(toks, markupToken{kind: tokOther, start: ix, end: end})
+			ix, textStart = end, end
+			continue
+		}
+		end := tagEnd(markup, ix)
+		if end < 0 {
+			ix++ // a lone '<' is text
+			continue
+		}
+		addText(ix)
+		name, closing := tagName(markup[ix:end])
+		toks = append(toks, markupToken{kind: tokTag, start: ix, end: end,
+			name: name, closing: closing})
+		ix, textStart = end, end
+
+		if !closing && (name == "style" || name == "script" || name == "head") {
+			closer := strings.Index(strings.ToLower(markup[ix:]), "</"+name)
+			if closer < 0 {
+				closer = len(markup) - ix
+			}
+			if closer > 0 {
+				toks = append(toks, markupToken{kind: tokOther, start: ix, end: ix + closer})
+			}
+			ix += closer
+			textStart = ix
+		}
+	}
+	addText(len(markup))
+	return toks
+}
+
+// htmlText returns the text content of an HTML fragment, such as the
+// text/html flavor of the clipboard. Tags and comments are dropped, as
+// is the content of <style>, <script> and <head>; <br> and the end of
+// block elements become newlines; entities are decoded.
+func htmlText(markup string) string {
+	var sb strings.Builder
+	for _, tok := range tokenizeMarkup(markup) {
+		switch {
+		case tok.kind == tokText:
+			sb.WriteString(html.UnescapeString(markup[tok.start:tok.end]))
+		case tok.kind == tokTag && (tok.name == "br" || (tok.closing && blockTags[tok.name])):
+			sb.WriteByte('\n')
+		}
+	}
+	return sb.String()
+}
+
+// tagEnd returns the index just past the '>' that ends the tag starting
+// at markup[start], honouring quoted attribute values, or -1 if the '<'
+// does not start a tag.
+func tagEnd(markup string, start int) int {
+	if start+1 >= len(markup) {
+		return -1
+	}
+	next := markup[start+1]
+	if !(next == '/' || next == '!' || (next|0x20 >= 'a' && next|0x20 <= 'z')) {
+		return -1
+	}
+	var quote byte
+	for ix := start + 1; ix < len(markup); ix++ {
+		c := markup[ix]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '>':
+			return ix + 1
+		}
+	}
+	return -1
+}
+
+// tagName returns the lowercase element name of a tag such as
+// `<span style="...">` or `</span>`, and whether it is a closing tag.
+func tagName(tag string) (name string, closing bool) {
+	tag = strings.TrimPrefix(tag, "<")
+	if strings.HasPrefix(tag, "/") {
+		closing = true
+		tag = tag[1:]
+	}
+	end := strings.IndexAny(tag, " \t\n\r\f/>")
+	if end < 0 {
+		end = len(tag)
+	}
+	return strings.ToLower(tag[:end]), closing
+}
+
+// tagAttr finds the attribute called name (lowercase) in tag, a complete
+// tag such as `<span style="color: red">`. It returns the value, still
+// entity-encoded, and its offsets in tag, not counting any quotes. An
+// attribute given without a value has an empty value.
+func tagAttr(tag string, name string) (value string, start int, end int, ok bool) {
+	ix := strings.IndexAny(tag, " \t\n\r\f/>")
+	if ix < 0 {
+		return "", 0, 0, false
+	}
+	isSpace := func(c byte) bool { return strings.IndexByte(" \t\n\r\f", c) >= 0 }
+	for ix < len(tag) {
+		for ix < len(tag) && (isSpace(tag[ix]) || tag[ix] == '/') {
+			ix++
+		}
+		if ix >= len(tag) || tag[ix] == '>' {
+			break
+		}
+		nameStart := ix
+		for ix < len(tag) && !isSpace(tag[ix]) && !strings.ContainsRune("=/>", rune(tag[ix])) {
+			ix++
+		}
+		attr := strings.ToLower(tag[nameStart:ix])
+		for ix < len(tag) && isSpace(tag[ix]) {
+			ix++
+		}
+		if ix >= len(tag) || tag[ix] != '=' {
+			if attr == name {
+				return "", ix, ix, true
+			}
+			continue
+		}
+		ix++
+		for ix < len(tag) && isSpace(tag[ix]) {
+			ix++
+		}
+		if ix < len(tag) && (tag[ix] == '"' || tag[ix] == '\'') {
+			quote := tag[ix]
+			start = ix + 1
+			end = strings.IndexByte(tag[start:], quote)
+			if end < 0 {
+				end = len(tag) - start
+			}
+			end += start
+			ix = end + 1
+		} else {
+			start = ix
+			for ix < len(tag) && !isSpace(tag[ix]) && tag[ix] != '>' {
+				ix++
+			}
+			end = ix
+		}
+		if attr == name {
+			return tag[start:end], start, end, true
+		}
+	}
+	return "", 0, 0, false
+}
+
+// styleProperty finds the last declaration of the CSS property prop
+// (lowercase) in the content of a style attribute, as the last one is
+// the one that applies. It returns the value, trimmed, and its offsets
+// in style.
+func styleProperty(style string, prop string) (value string, start int, end int, ok bool) {
+	pos := 0
+	for _, decl := range strings.SplitAfter(style, ";") {
+		declStart := pos
+		pos += len(decl)
+		colon := strings.IndexByte(decl, ':')
+		if colon < 0 || strings.ToLower(strings.TrimSpace(decl[:colon])) != prop {
+			continue
+		}
+		raw := strings.TrimSuffix(decl[colon+1:], ";")
+		lead := len(raw) - len(strings.TrimLeft(raw, " \t\n\r\f"))
+		value = strings.TrimSpace(raw)
+		start = declStart + colon + 1 + lead
+		end = start + len(value)
+		ok = true
+	}
+	return value, start, end, ok
+}
+
+// cssColors are the colors one style attribute or stylesheet rule sets,
+// with the offsets of their values in the fragment so that they can be
+// rewritten. A color the declarations do not set is "". A value that
+// is meant as a color but cannot be resolved to one, such as a
+// translucent rgba() or a var(), is kept in fgUnparsed or bgUnparsed
+// instead.
+type cssColors struct {
+	fg         string
+	fgPos      [2]int
+	bg         string
+	bgPos      [2]int
+	fgUnparsed string
+	bgUnparsed string
+}
+
+// declColors returns the colors set by CSS declarations, such as the
+// content of a style attribute or of a rule, found at offset in the
+// fragment. The declarations are still entity-encoded if they come from
+// an attribute; a color value never needs entities.
+func declColors(decls string, offset int) (c cssColors) {
+	value, start, end, found := styleProperty(decls, "color")
+	value = html.UnescapeString(value)
+	if found {
+		hex, known := cssColor(value)
+		switch {
+		case known:
+			c.fg, c.fgPos = hex, [2]int{offset + start, offset + end}
+		case !cssKeepsColor(value):
+			c.fgUnparsed = value
+		}
+	}
+
+	shorthand := false
+	value, start, end, found = styleProperty(decls, "background-color")
+	if !found {
+		value, start, end, found = styleProperty(decls, "background")
+		shorthand = true
+	}
+	value = html.UnescapeString(value)
+	if found {
+		hex, known := cssColor(value)
+		switch {
+		case known:
+			c.bg, c.bgPos = hex, [2]int{offset + start, offset + end}
+		case cssKeepsColor(value):
+		case !shorthand || rxCSSColorSyntax.MatchString(value):
+			// the background shorthand may hold images and the like;
+			// only a lone color that is not understood is reported
+			c.bgUnparsed = value
+		}
+	}
+	return c
+}
+
+// cssKeepsColor reports whether a color value leaves the color as the
+// parent has it (or makes it transparent, which shows the parent's
+// background), so that nothing is lost by ignoring it.
+func cssKeepsColor(value string) bool {
+	switch strings.ToLower(value) {
+	case "inherit", "initial", "unset", "revert", "currentcolor", "transparent":
+		return true
+	}
+	return false
+}
+
+// rxCSSColorSyntax matches a value that can only be meant as one color:
+// a hex value or a color function.
+var rxCSSColorSyntax = regexp.MustCompile(`(?i)^(#[0-9a-f]+|(rgba?|hsla?|hwb|lab|lch|oklab|oklch|color|var)\(.*\))$`)
+
+// rxCSSColorFunc matches rgb(), rgba(), hsl() and hsla(), with their
+// arguments separated by commas or spaces (and the alpha by "/").
+var rxCSSColorFunc = regexp.MustCompile(`(?i)^(rgba?|hsla?)\(\s*([^)]*?)\s*\)$`)
+
+// cssColor resolves a CSS color value to "#rrggbb": anything
+// StringToColor accepts, rgb(), rgba(), hsl(), hsla(), and #rgba or
+// #rrggbbaa, as long as the color is opaque. A translucent color
+// depends on what is behind it, so it is not known.
+func cssColor(value string) (hex string, known bool) {
+	hex, known = htmlColor.StringToColor(value)
+	if known {
+		return hex, true
+	}
+	value = strings.TrimSpace(value)
+	if 5 == len(value) || 9 == len(value) {
+		opaque := map[int]string{5: "f", 9: "ff"}[len(value)]
+		if strings.HasPrefix(value, "#") && strings.EqualFold(value[len(value)-len(opaque):], opaque) {
+			return htmlColor.StringToColor(value[:len(value)-len(opaque)])
+		}
+		return "", false
+	}
+
+	m := rxCSSColorFunc.FindStringSubmatch(value)
+	if nil == m {
+		return "", false
+	}
+	args := strings.FieldsFunc(strings.ReplaceAll(m[2], "/", " "), func(r rune) bool {
+		return ',' == r || ' ' == r || '\t' == r
+	})
+	if 4 == len(args) {
+		alpha, err := cssNumber(args[3], 1)
+		if nil != err || 1 != alpha {
+			return "", false
+		}
+		args = args[:3]
+	}
+	if 3 != len(args) {
+		return "", false
+	}
+
+	if strings.HasPrefix(strings.ToLower(m[1]), "rgb") {
+		var rgb [3]float64
+		for ix, arg := range args {
+			v, err := cssNumber(arg, 255)
+			if nil != err || v < 0 || v > 255 {
+				return "", false
+			}
+			rgb[ix] = math.Round(v)
+		}
+		return fmt.Sprintf("#%02x%02x%02x", int(rgb[0]), int(rgb[1]), int(rgb[2])), true
+	}
+
+	h, err := strconv.ParseFloat(strings.TrimSuffix(strings.ToLower(args[0]), "deg"), 64)
+	if nil != err {
+		return "", false
+	}
+	sat, err1 := cssNumber(args[1], 1)
+	light, err2 := cssNumber(args[2], 1)
+	if nil != err1 || nil != err2 || !strings.HasSuffix(args[1], "%") || !strings.HasSuffix(args[2], "%") {
+		return "", false
+	}
+	return htmlColor.HSLToHex(h, math.Max(0, math.Min(1, sat)), math.Max(0, math.Min(1, light))), true
+}
+
+// cssNumber parses a CSS number, or a percentage of full.
+func cssNumber(arg string, full float64) (float64, error) {
+	if pct, ok := strings.CutSuffix(arg, "%"); ok {
+		v, err := strconv.ParseFloat(pct, 64)
+		return v * full / 100, err
+	}
+	return strconv.ParseFloat(arg, 64)
+}
+
+// rxClassRule matches a stylesheet rule for a single class, such as the
+// rules renderClasses writes: `.mc-red { color: #ff0000; }`.
+var rxClassRule = regexp.MustCompile(`\.([A-Za-z_][-\w]*)\s*\{([^}]*)\}`)
+
+// classColors returns the colors of each class set by the <style>
+// elements of an HTML fragment. Only rules for a single class are
+// understood.
+func classColors(markup string, toks []markupToken) map[string]cssColors {
+	classes := make(map[string]cssColors)
+	for ix, tok := range toks {
+		if tokOther != tok.kind || 0 == ix || "style" != toks[ix-1].name {
+			continue
+		}
+		sheet := markup[tok.start:tok.end]
+		for _, m := range rxClassRule.FindAllStringSubmatchIndex(sheet, -1) {
+			classes[sheet[m[2]:m[3]]] = declColors(sheet[m[4]:m[5]], tok.start+m[4])
+		}
+	}
+	return classes
+}
+
+// styledText is a text token of an HTML fragment with the colors it is
+// shown in, as set by the inline styles and classes of its enclosing
+// elements. fgPos, the position of the declaration that sets fg, also
+// identifies it: text colored by the same span or the same class rule
+// has the same fgPos.
+type styledText struct {
+	tok   markupToken
+	fg    string // "#rrggbb", or "" if no element sets a color
+	bg    string // "#rrggbb", or "" if no element sets a background
+	fgPos [2]int // offsets in the fragment of the fg value
+	bgPos [2]int // offsets in the fragment of the bg value
+	ownBg bool   // bg is set along with fg, by the same span or rule
+
+	// the color values that apply to the text but could not be
+	// resolved (see cssColors), or ""
+	fgUnparsed string
+	bgUnparsed string
+}
+
+// styleFrame is an open element and the colors inside it.
+type styleFrame struct {
+	name string
+	styledText
+}
+
+// styleTexts resolves the `color` and `background-color` of each text
+// token of an HTML fragment, from the inline styles of its enclosing
+// elements and the classes defined by its <style> elements (an inline
+// style wins over a class). Colors are resolved through cssColor, so
+// names, hex values, rgb() and hsl() are understood; where a value is
+// not, the color is unknown and the value is kept in fgUnparsed or
+// bgUnparsed.
+func styleTexts(markup string, toks []markupToken) (texts []styledText) {
+	classes := classColors(markup, toks)
+	stack := []styleFrame{{}}
+
+	for _, tok := range toks {
+		top := stack[len(stack)-1]
+		switch {
+		case tok.kind == tokText:
+			st := top.styledText
+			st.tok = tok
+			texts = append(texts, st)
+		case tok.kind == tokTag && tok.closing:
+			for jx := len(stack) - 1; jx > 0; jx-- {
+				if stack[jx].name == tok.name {
+					stack = stack[:jx]
+					break
+				}
+			}
+		case tok.kind == tokTag:
+			tag := markup[tok.start:tok.end]
+			if voidTags[tok.name] || strings.HasSuffix(tag, "/>") {
+				continue
+			}
+			frame := top
+			frame.name = tok.name
+			var set []cssColors
+			if class, _, _, ok := tagAttr(tag, "class"); ok {
+				for _, name := range strings.Fields(html.UnescapeString(class)) {
+					if c, ok := classes[name]; ok {
+						set = append(set, c)
+					}
+				}
+			}
+			if style, start, _, ok := tagAttr(tag, "style"); ok {
+				set = append(set, declColors(style, tok.start+start))
+			}
+			for _, c := range set {
+				if "" != c.fg || "" != c.fgUnparsed {
+					frame.fg, frame.fgPos, frame.ownBg = c.fg, c.fgPos, false
+					frame.fgUnparsed = c.fgUnparsed
+				}
+				if "" != c.bg || "" != c.bgUnparsed {
+					frame.bg, frame.bgPos = c.bg, c.bgPos
+					frame.ownBg = "" != c.fg
+					frame.bgUnparsed = c.bgUnparsed
+				}
+			}
+			stack = append(stack, frame)
+		}
+	}
+	return texts
+}
+
+// lineCol returns the 1-based line and column (in characters) of the
+// byte offset pos in text.
+func lineCol(text string, pos int) (line int, col int) {
+	before := text[:pos]
+	line = 1 + strings.Count(before, "\n")
+	col = 1 + len([]rune(before[strings.LastIndexByte(before, '\n')+1:]))
+	return line, col
+}
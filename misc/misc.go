@@ -1,13 +1,11 @@
 package misc
 
 import (
-	"bufio"
 	"errors"
 	"fmt"
-	"log"
+	"log/slog"
 	"os"
 	"os/user"
-	"path"
 	"path/filepath"
 	"runtime"
 	"strings"
@@ -22,52 +20,33 @@ var emptyString = ""
 
 var mDebug = false
 var mVerbose = false
-var xLog *log.Logger = nil
-var mFatal func(...int) = miscExit
+var xLog *slog.Logger = nil
 
 func reportError(msg ...string) {
 	for _, m := range msg {
 		n := SafeString(&m)
 		if nil != xLog {
-			xLog.Println(n)
+			xLog.Error(*n)
 		} else {
 			_, _ = fmt.Fprintln(os.Stderr, n)
 		}
 	}
 }
 
-// miscExit terminates the current program with the
-// given exit code, or 0 if no code is provided. It's
-// a default, in case it's not overridden by MyFatal()
-// or something similar from the main package. Must
-// call indirectly because the function takes an
-// array of exit values to permit a naked call from
-// MyFatal().
-func miscExit(code ...int) {
-	var rc int = -4
-	if len(code) <= 0 {
-		rc = code[0]
-	}
-	os.Exit(rc)
-}
-
 // SetOptions configures the debug mode, verbose mode, and logger to
-// primary logging tool, as well as the exit function. These options
-// are NOT required to be set; there are reasonable default:
+// primary logging tool. These options are NOT required to be set; there
+// are reasonable default:
 // debug == false
 // verbose == false
 // logging goes to os.Stderr
-// fatal function goes to os.Exit(-4).
-func SetOptions(debug bool, verbose bool, mainLogger *log.Logger, fatal func(...int)) {
+// The package never exits the program; it returns errors instead.
+func SetOptions(debug bool, verbose bool, mainLogger *slog.Logger) {
 	mDebug = debug
 	mVerbose = verbose
-	// do not point to nil functions :-)
+	// do not point to nil loggers :-)
 	if nil != mainLogger {
 		xLog = mainLogger
 	}
-	if nil != fatal {
-		mFatal = fatal
-	}
 }
 
 // SafeString returns either the pointer to the string,
@@ -148,49 +127,3 @@ func ConcatenateErrors(errList ...error) error {
 	}
 	return nil
 }
-
-// RecordString writes strings received from the inTx channel to a specified file in outDir with outFileName.
-// When the function completes, it calls the provided wgDone function. Each string is written followed by a newline.
-// If an error occurs during file operations, the function panics. The function is intended to run in a
-// goroutine, and is closed by closing the `inTx` channel.
-func RecordString(outDir string, outFileName string, inTx <-chan string, wgDone func()) {
-	defer wgDone()
-
-	bout, err := os.OpenFile(path.Join(outDir, outFileName),
-		os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0666)
-	if nil != err {
-		msg := fmt.Sprintf("Failed to open %s because %s\n",
-			path.Join(outDir, outFileName), err.Error())
-		reportError(msg)
-		mFatal(-3)
-	}
-	defer DeferError(bout.Close)
-
-	bw := bufio.NewWriterSize(bout, 1024*4)
-	defer DeferError(bw.Flush)
-
-	for val := range inTx {
-		_, err = bw.WriteString(val)
-		if nil != err {
-			msg := fmt.Sprintf("failed to write string %s to file %s because %s\n",
-				val, outFileName, err.Error())
-			reportError(msg)
-			mFatal(-3)
-		}
-		err = bw.WriteByte('\n')
-		if nil != err {
-			msg := fmt.Sprintf("failed to write newline following string %s to file %s because %s\n",
-				val, outFileName, err.Error())
-			reportError(msg)
-			mFatal(-3)
-		}
-	}
-
-	/***********
-	 * DEFERRED ACTIONS
-	 * flush buffer_out
-	 * close file
-	 * call wgDone (waitGroup done)
-	 **********/
-
-}
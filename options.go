@@ -0,0 +1,134 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	htmlColor "madcolor/htmlcolor"
+)
+
+// output formats (see --format)
+const (
+	formatHTML = "html"
+	formatANSI = "ansi"
+)
+
+// what to do when no color meets the contrast and distance (see
+// --on-unsatisfiable)
+const (
+	unsatFail       = "fail"
+	unsatRelax      = "relax"
+	unsatBestEffort = "best-effort"
+)
+
+// unsatPolicies lists the values --on-unsatisfiable accepts.
+var unsatPolicies = []string{unsatFail, unsatRelax, unsatBestEffort}
+
+// rxClassPrefix matches a --class-prefix that starts a valid CSS class
+// name whatever follows it, and that the class rules audit and recolor
+// read (rxClassRule) match: an ASCII letter or underscore, then letters,
+// digits, hyphens and underscores.
+var rxClassPrefix = regexp.MustCompile(`^[A-Za-z_][-A-Za-z0-9_]*$`)
+
+// relaxStep is how many points --on-unsatisfiable relax lowers the
+// contrast and distance by at a time.
+const relaxStep = 10
+
+// colorOptions are the settings for one colorize pass. The command line
+// builds them from the flags (optionsFromFlags); the server and RPC modes
+// decode them from JSON, so the JSON names are the flag names.
+type colorOptions struct {
+	Background  string `json:"background-color"`
+	Contrast    int    `json:"contrast"`
+	Distance    int    `json:"distance"`
+	Invent      bool   `json:"invent"`
+	Anti        bool   `json:"anti"`
+	Mode        string `json:"mode"`
+	Unit        string `json:"unit"`
+	Salt        string `json:"salt"`
+	Format      string `json:"format"`
+	CSS         string `json:"css"`
+	CSSFile     string `json:"-"`
+	ClassPrefix string `json:"class-prefix"`
+	Optimize    bool   `json:"optimize"`
+	Annotate    bool   `json:"annotate"`
+
+	OnUnsatisfiable string `json:"on-unsatisfiable"`
+}
+
+// defaultColorOptions returns the options colorize uses when no flags
+// are given.
+func defaultColorOptions() colorOptions {
+	return colorOptions{
+		Background:  "white",
+		Contrast:    minContrast + 10, // named colors; see main
+		Distance:    minColorDistance,
+		Mode:        modeRandom,
+		Unit:        unitGlyph,
+		Format:      formatHTML,
+		CSS:         cssInline,
+		ClassPrefix: "mc-",
+
+		OnUnsatisfiable: unsatBestEffort,
+	}
+}
+
+// optionsFromFlags returns the colorize options set on the command line.
+func optionsFromFlags() colorOptions {
+	return colorOptions{
+		Background:  FlagBackgroundColor,
+		Contrast:    int(FlagContrast),
+		Distance:    int(FlagDistance),
+		Invent:      FlagInventColor,
+		Anti:        FlagAntiColor,
+		Mode:        FlagMode,
+		Unit:        FlagUnit,
+		Salt:        FlagSalt,
+		Format:      FlagFormat,
+		CSS:         FlagCSS,
+		CSSFile:     FlagCSSFile,
+		ClassPrefix: FlagClassPrefix,
+		Optimize:    FlagOptimize,
+		Annotate:    FlagAnnotate,
+
+		OnUnsatisfiable: FlagOnUnsatisfiable,
+	}
+}
+
+// validate reports every option with a value colorize does not accept.
+// The messages name the options as flags.
+func (o colorOptions) validate() error {
+	var errList []error
+	check := func(name string, value string, allowed ...string) {
+		for _, a := range allowed {
+			if value == a {
+				return
+			}
+		}
+		errList = append(errList, fmt.Errorf("--%s must be one of %v, not %q", name, allowed, value))
+	}
+
+	check("mode", o.Mode, modeRandom, modeHash)
+	check("unit", o.Unit, unitGlyph, unitWord)
+	check("format", o.Format, formatHTML, formatANSI)
+	check("css", o.CSS, cssInline, cssClasses)
+	check("on-unsatisfiable", o.OnUnsatisfiable, unsatPolicies...)
+	if o.Contrast < 0 || o.Contrast > 100 {
+		errList = append(errList, fmt.Errorf("--contrast must be 0 to 100, not %d", o.Contrast))
+	}
+	if o.Distance < 0 || o.Distance > 100 {
+		errList = append(errList, fmt.Errorf("--distance must be 0 to 100, not %d", o.Distance))
+	}
+	if !rxClassPrefix.MatchString(o.ClassPrefix) {
+		errList = append(errList, fmt.Errorf(
+			"--class-prefix must start with a letter or _ and hold only letters, digits, - and _, not %q",
+			o.ClassPrefix))
+	}
+	if "" != o.Background {
+		if _, err := htmlColor.ParseColor(o.Background); nil != err {
+			errList = append(errList, fmt.Errorf("--background-color: %w", err))
+		}
+	}
+	return errors.Join(errList...)
+}
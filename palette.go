@@ -0,0 +1,179 @@
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/pflag"
+	htmlColor "madcolor/htmlcolor"
+)
+
+// palette export formats (see madcolor palette export --format)
+const (
+	exportCSS  = "css"
+	exportJSON = "json"
+	exportCSV  = "csv"
+	exportGPL  = "gpl"
+)
+
+var exportFormats = []string{exportCSS, exportJSON, exportCSV, exportGPL}
+
+var FlagExportFormat string
+var FlagExportPrefix string
+var FlagPaletteSource []string
+
+// rxNotSlug matches the runs of characters a CSS custom property name
+// should not hold.
+var rxNotSlug = regexp.MustCompile("[^a-z0-9]+")
+
+// newPaletteFlags returns the flag set of the palette command.
+func newPaletteFlags() *commandFlags {
+	pFlags := newCommandFlags("palette")
+
+	pFlags.StringVarP(&FlagExportFormat, "format", "f", exportCSS,
+		fmt.Sprintf("Format for export, one of %v", exportFormats))
+	pFlags.StringVarP(&FlagExportPrefix, "prefix", "", "",
+		"Prefix for the custom property names of a css export")
+	addSearchFlags(pFlags)
+	addPaletteSourceFlag(pFlags.FlagSet)
+	completeFlag(pFlags.FlagSet, "format", completeChoice, exportFormats...)
+	return pFlags
+}
+
+// runPalette implements `madcolor palette list|search [query]|export|lint`.
+// list and search take the filters of addSearchFlags and show swatches.
+func runPalette(args []string) error {
+	pFlags := newPaletteFlags()
+
+	args, err := parseCommandFlags(pFlags, args)
+	if nil == err {
+		err = requireArgs(pFlags, args, 1, 2)
+	}
+	if nil == err {
+		err = setPaletteSources()
+	}
+	if nil != err {
+		return err
+	}
+
+	switch args[0] {
+	case "list":
+		err = requireArgs(pFlags, args, 1, 1)
+		if nil != err {
+			return err
+		}
+		matches, err := searchPalette(pFlags, htmlColor.Palette(), "")
+		if nil != err {
+			return err
+		}
+		return printMatches(pFlags, matches)
+	case "search":
+		query := ""
+		if 2 == len(args) {
+			query = args[1]
+		}
+		matches, err := searchPalette(pFlags, htmlColor.Palette(), query)
+		if nil != err {
+			return err
+		}
+		return printMatches(pFlags, matches)
+	case "export":
+		err = requireArgs(pFlags, args, 1, 1)
+		if nil != err {
+			return err
+		}
+		return exportPalette(htmlColor.Palette(), FlagExportFormat)
+	case "lint":
+		err = requireArgs(pFlags, args, 1, 1)
+		if nil != err {
+			return err
+		}
+		return lintPalette(htmlColor.Duplicates())
+	}
+	commandUsage(pFlags)
+	return usageErrorf("palette takes list, search, export or lint, not %q", args[0])
+}
+
+// addPaletteSourceFlag adds --palette-source to fs.
+func addPaletteSourceFlag(fs *pflag.FlagSet) {
+	fs.StringSliceVarP(&FlagPaletteSource, "palette-source", "", []string{"all"},
+		"Only use the named colors of these sources: all, or any of "+
+			strings.Join(htmlColor.Sources(), ", ")+" (css includes css-basic)")
+	completeFlag(fs, "palette-source", completeChoice, append(htmlColor.Sources(), "all")...)
+}
+
+// setPaletteSources limits the palette to the sources of
+// --palette-source. A source that does not exist is an ErrUsage.
+func setPaletteSources() error {
+	err := htmlColor.SetPaletteSources(FlagPaletteSource...)
+	if nil != err {
+		return usageErrorf("--palette-source: %w", err)
+	}
+	return nil
+}
+
+// lintPalette writes the names the palette leaves out because another
+// name has the same hex value, as text or, with --json, as JSON. It
+// returns an ErrCheckFailed when there are any, so that scripts can act
+// on them.
+func lintPalette(dups []htmlColor.Duplicate) (err error) {
+	if FlagPaletteJSON {
+		err = printJSON(dups)
+	} else {
+		out := bufio.NewWriter(os.Stdout)
+		for _, d := range dups {
+			_, _ = fmt.Fprintf(out, "duplicate color hex %s has names %s and %s\n", d.Hex, d.Name, d.Dropped)
+		}
+		err = flushOutput(out)
+	}
+	if nil == err && 0 < len(dups) {
+		err = fmt.Errorf("%w: %d duplicate color name(s)", ErrCheckFailed, len(dups))
+	}
+	return err
+}
+
+// exportPalette writes the palette to stdout in one of exportFormats:
+// CSS custom properties, JSON, CSV or a GIMP palette.
+func exportPalette(colors []htmlColor.NamedColor, format string) error {
+	out := bufio.NewWriter(os.Stdout)
+
+	switch format {
+	case exportCSS:
+		_, _ = out.WriteString(":root {\n")
+		for _, c := range colors {
+			slug := strings.Trim(rxNotSlug.ReplaceAllString(strings.ToLower(c.Name), "-"), "-")
+			_, _ = fmt.Fprintf(out, "  --%s%s: %s;\n", FlagExportPrefix, slug, c.Hex)
+		}
+		_, _ = out.WriteString("}\n")
+	case exportJSON:
+		return printJSON(colors)
+	case exportCSV:
+		cw := csv.NewWriter(out)
+		_ = cw.Write([]string{"name", "display", "hex", "red", "green", "blue"})
+		for _, c := range colors {
+			r, g, b, err := htmlColor.RGB(c.Hex)
+			if nil != err {
+				return err
+			}
+			_ = cw.Write([]string{c.Name, c.Display, c.Hex, strconv.Itoa(r), strconv.Itoa(g), strconv.Itoa(b)})
+		}
+		cw.Flush()
+	case exportGPL:
+		_, _ = out.WriteString("GIMP Palette\nName: madcolor\nColumns: 8\n#\n")
+		for _, c := range colors {
+			r, g, b, err := htmlColor.RGB(c.Hex)
+			if nil != err {
+				return err
+			}
+			_, _ = fmt.Fprintf(out, "%3d %3d %3d\t%s\n", r, g, b, c.Display)
+		}
+	default:
+		return usageErrorf("palette export --format must be one of %v, not %q", exportFormats, format)
+	}
+	return flushOutput(out)
+}
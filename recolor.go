@@ -0,0 +1,175 @@
+package main
+
+import (
+	"fmt"
+	"html"
+	"os"
+	"strings"
+
+	htmlColor "madcolor/htmlcolor"
+	"madcolor/misc"
+)
+
+var FlagOnlyFailing bool
+var FlagRecolorOutput string
+var FlagSeed string
+
+// newRecolorFlags returns the flag set of the recolor command.
+func newRecolorFlags() *commandFlags {
+	rFlags := newCommandFlags("recolor")
+
+	rFlags.StringVarP(&FlagBackgroundColor, "background-color", "b", "white",
+		"Background the colors are picked against, where the markup sets none")
+	rFlags.VarP(newContrastValue(&FlagContrast, int8(minContrast)), "contrast", "c",
+		"minimum relative contrast between foreground and background, as a percentage "+
+			"or a WCAG level (AA, AA-large, AAA, AAA-large) or ratio (4.5:1)")
+	rFlags.Int8VarP(&FlagDistance, "distance", "D", int8(minColorDistance),
+		"minimum RGB distance between foreground and background, as a percentage of black to white")
+	rFlags.StringVarP(&FlagOnUnsatisfiable, "on-unsatisfiable", "", unsatBestEffort,
+		"When no color meets --contrast and --distance: fail, relax them, or best-effort (black or white)")
+	rFlags.BoolVarP(&FlagInventColor, "invent", "I", false,
+		"randomly generate colors (rather than randomly select known/named colors)")
+	rFlags.StringVarP(&FlagMode, "mode", "m", modeRandom,
+		"Color selection mode: random, or hash (the same text always gets the same color)")
+	rFlags.StringVarP(&FlagSalt, "salt", "", "",
+		"Salt mixed into the hash for --mode hash (different salt, different colors)")
+	rFlags.StringVarP(&FlagSeed, "seed", "", "",
+		"Seed for --mode random, so that the same seed picks the same colors again")
+	addPaletteSourceFlag(rFlags.FlagSet)
+	rFlags.BoolVarP(&FlagOnlyFailing, "only-failing", "", false,
+		"Only re-pick the colors that do not meet --contrast and --distance")
+	rFlags.StringVarP(&FlagRecolorOutput, "output", "o", "",
+		"Write the recolored markup to this file rather than stdout")
+	completeFlag(rFlags.FlagSet, "background-color", completeColor)
+	completeFlag(rFlags.FlagSet, "contrast", completeChoice, wcagLevelNames()...)
+	completeFlag(rFlags.FlagSet, "on-unsatisfiable", completeChoice, unsatPolicies...)
+	completeFlag(rFlags.FlagSet, "mode", completeChoice, modeRandom, modeHash)
+	completeFlag(rFlags.FlagSet, "output", completeFile)
+	return rFlags
+}
+
+// runRecolor implements `madcolor recolor [file]`: it re-picks the
+// colors of madcolor HTML (from the file, or stdin) under new settings,
+// keeping the text and the spans as they are. Inline styles and class
+// rules are both rewritten where the old color was; a span that had a
+// background of its own (--anti output) gets a new one too.
+func runRecolor(args []string) error {
+	rFlags := newRecolorFlags()
+
+	args, err := parseCommandFlags(rFlags, args)
+	if nil == err {
+		err = requireArgs(rFlags, args, 0, 1)
+	}
+	if nil == err {
+		err = setPaletteSources()
+	}
+	if nil != err {
+		return err
+	}
+	htmlColor.SetSeed(FlagSeed)
+	defer htmlColor.SetSeed("")
+	if !FlagInventColor && !rFlags.Changed("contrast") {
+		FlagContrast += 10 // as for colorize
+	}
+
+	opts := defaultColorOptions()
+	opts.Background = FlagBackgroundColor
+	opts.Contrast = int(FlagContrast)
+	opts.Distance = int(FlagDistance)
+	opts.Invent = FlagInventColor
+	opts.Mode = FlagMode
+	opts.Salt = FlagSalt
+	opts.OnUnsatisfiable = FlagOnUnsatisfiable
+	err = opts.validate()
+	if nil != err {
+		return usageErrorf("%w", err)
+	}
+
+	file := "-"
+	if 1 == len(args) {
+		file = args[0]
+	}
+	markup, err := readMarkup(file)
+	if nil != err {
+		return fmt.Errorf("%w %s: %w", ErrInput, file, err)
+	}
+
+	out, count, err := recolorMarkup(string(markup), opts, FlagOnlyFailing)
+	if nil != err {
+		return err
+	}
+	xLog.Info("recolored", "colors", count)
+
+	if misc.IsStringSet(&FlagRecolorOutput) {
+		err = os.WriteFile(FlagRecolorOutput, []byte(out), 0666)
+	} else {
+		_, err = os.Stdout.WriteString(out)
+	}
+	if nil != err {
+		return fmt.Errorf("%w: %w", ErrOutput, err)
+	}
+	return nil
+}
+
+// recolorMarkup re-picks each color of markup that sets the color of
+// some text, with pickColors, against the background the text is shown
+// on (opts.Background where the markup sets none). A color shared by
+// several runs of text, such as a class rule, is picked once, from its
+// first text. With onlyFailing, colors that already satisfy the contrast
+// and distance of opts are kept. It returns the new markup and the
+// number of colors changed, or the first error of pickColors.
+func recolorMarkup(markup string, opts colorOptions, onlyFailing bool) (string, int, error) {
+	var edits []colorEdit
+
+	background := "#ffffff"
+	if "" != opts.Background {
+		var err error
+		background, err = htmlColor.ParseColor(opts.Background)
+		if nil != err {
+			return "", 0, err
+		}
+	}
+	err := checkSatisfiable(background, &opts)
+	if nil != err {
+		return "", 0, err
+	}
+	done := make(map[int]bool) // by fgPos[0]
+
+	for _, st := range styleTexts(markup, tokenizeMarkup(markup)) {
+		if "" == st.fg || done[st.fgPos[0]] {
+			continue
+		}
+		done[st.fgPos[0]] = true
+
+		bg := st.bg
+		if "" == bg {
+			bg = background
+		}
+		if onlyFailing && htmlColor.Satisfies(st.fg, bg, opts.Contrast, opts.Distance) {
+			continue
+		}
+
+		unitOpts := opts
+		unitOpts.Anti = st.ownBg
+		_, fg, _, ownBg, err := pickColors(html.UnescapeString(markup[st.tok.start:st.tok.end]), bg, unitOpts)
+		if nil != err {
+			return "", 0, err
+		}
+		edits = append(edits, colorEdit{start: st.fgPos[0], end: st.fgPos[1],
+			hex: sameNotation(markup[st.fgPos[0]:st.fgPos[1]], fg)})
+		if st.ownBg {
+			edits = append(edits, colorEdit{start: st.bgPos[0], end: st.bgPos[1],
+				hex: sameNotation(markup[st.bgPos[0]:st.bgPos[1]], ownBg)})
+		}
+	}
+	return applyColorEdits(markup, edits), len(edits), nil
+}
+
+// sameNotation returns hex ("#rrggbb") in the short "#rgb" form when
+// the value it replaces is written that way, as --optimize writes it.
+func sameNotation(old string, hex string) string {
+	if 4 == len(old) && strings.HasPrefix(old, "#") {
+		return shortHex(hex)
+	}
+	return strings.ToLower(hex)
+}
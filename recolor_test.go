@@ -0,0 +1,84 @@
+package main
+
+import (
+	"path/filepath"
+	"regexp"
+	"testing"
+)
+
+// TestRecolorSeed checks that --seed makes recolor reproducible, with
+// named and invented colors, and that another seed picks other colors.
+func TestRecolorSeed(t *testing.T) {
+	dir := isolate(t)
+	colored := filepath.Join(dir, "colored")
+	err := run(t, "colorize", "--text", "The quick brown fox jumps over the lazy dog",
+		"--buff=false", "--nopaste=false", "--output-dir", dir, "--output", "colored")
+	if nil != err {
+		t.Fatalf("colorize: %v", err)
+	}
+
+	recolor := func(args ...string) string {
+		out := filepath.Join(dir, "recolored")
+		err := run(t, append([]string{"recolor", "--output", out, colored}, args...)...)
+		if nil != err {
+			t.Fatalf("recolor %v: %v", args, err)
+		}
+		return readFile(t, out)
+	}
+
+	for _, invent := range []string{"--invent=false", "--invent"} {
+		first := recolor("--seed", "one", invent)
+		if again := recolor("--seed", "one", invent); first != again {
+			t.Errorf("%s: the same seed gave\n%s\nthen\n%s", invent, first, again)
+		}
+		if other := recolor("--seed", "two", invent); first == other {
+			t.Errorf("%s: seeds one and two gave the same colors", invent)
+		}
+	}
+}
+
+// TestHashMode checks that --mode hash is deterministic: the same word,
+// or glyph, gets the same color in every run and wherever it is in the
+// input, and another --salt gives other colors.
+func TestHashMode(t *testing.T) {
+	rxSpan := regexp.MustCompile(`<span style="color: (#[0-9a-f]{6});">([^<]*)</span>`)
+	dir := isolate(t)
+	colors := func(text string, unit string, salt string) map[string]string {
+		t.Helper()
+		err := run(t, "colorize", "--text", text, "--buff=false", "--nopaste=false",
+			"--mode", modeHash, "--unit", unit, "--salt", salt, "--background-color", "white",
+			"--output-dir", dir, "--output", "colored")
+		if nil != err {
+			t.Fatalf("colorize: %v", err)
+		}
+		byToken := make(map[string]string)
+		for _, m := range rxSpan.FindAllStringSubmatch(readFile(t, filepath.Join(dir, "colored")), -1) {
+			if seen, ok := byToken[m[2]]; ok && seen != m[1] {
+				t.Errorf("%s %q is %s in one place and %s in another", unit, m[2], seen, m[1])
+			}
+			byToken[m[2]] = m[1]
+		}
+		return byToken
+	}
+
+	for _, unit := range []string{unitWord, unitGlyph} {
+		first := colors("alpha beta gamma alpha beta", unit, "")
+		again := colors("gamma beta alpha", unit, "")
+		salted := colors("alpha beta gamma alpha beta", unit, "pepper")
+		if 0 == len(first) {
+			t.Fatalf("%s: no colored spans", unit)
+		}
+		changed := 0
+		for token, color := range first {
+			if again[token] != color {
+				t.Errorf("%s %q is %s in one run and %s in another", unit, token, color, again[token])
+			}
+			if salted[token] != color {
+				changed++
+			}
+		}
+		if 0 == changed {
+			t.Errorf("%s: --salt did not change any color", unit)
+		}
+	}
+}
@@ -0,0 +1,361 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"html"
+	"log/slog"
+	"strconv"
+	"strings"
+
+	htmlColor "madcolor/htmlcolor"
+	"madcolor/misc"
+)
+
+// output styles (see --css)
+const (
+	cssInline  = "inline"
+	cssClasses = "classes"
+)
+
+// antiPadding is added to every span that has its own background color,
+// so that the background of adjacent glyphs does not look clipped.
+const antiPadding = "padding: 0px 0px 1px 0px"
+
+// antiPaddingMin is antiPadding with minimal syntax, for --optimize.
+const antiPaddingMin = "padding:0 0 1px"
+
+// coloredRun is one unit of text (see --unit) and the colors selected
+// for it. bg is only set when the run has its own background (--anti).
+// The names are "" when the color was invented rather than named.
+type coloredRun struct {
+	text   string
+	fg     string
+	fgName string
+	bg     string
+	bgName string
+}
+
+// htmlEscaper escapes the characters that would otherwise be taken
+// as markup when the text is placed inside a <span>.
+var htmlEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// renderRuns writes the colored runs to out in the format selected by
+// opts: ANSI escape sequences, or HTML with either an inline style
+// attribute on each span or, with --css classes, class references plus
+// a stylesheet that defines each fg/bg pair once. If the stylesheet
+// goes to opts.CSSFile, it is returned for the caller to write.
+// With --optimize, the runs are coalesced by optimizeRuns first and
+// the markup is minified.
+func renderRuns(runs []coloredRun, out *bufio.Writer, opts colorOptions) (sheet string) {
+	var w = NewOTWriter(out)
+
+	if !opts.Optimize {
+		return writeRuns(w, runs, opts, false)
+	}
+
+	optimized := optimizeRuns(runs)
+	sheet = writeRuns(w, optimized, opts, true)
+
+	if xLog.Enabled(context.Background(), slog.LevelInfo) {
+		before := renderedSize(runs, opts, false)
+		after := renderedSize(optimized, opts, true)
+		xLog.Info("optimized output", "bytes", before, "optimized_bytes", after,
+			"smaller_percent", fmt.Sprintf("%.1f", 100.0*float64(before-after)/float64(max(before, 1))),
+			"spans", len(runs), "optimized_spans", len(optimized))
+	}
+	return sheet
+}
+
+// renderedSize returns the number of bytes writeRuns produces for runs,
+// including the stylesheet.
+func renderedSize(runs []coloredRun, opts colorOptions, minify bool) int {
+	var buf bytes.Buffer
+	bw := bufio.NewWriter(&buf)
+	sheet := writeRuns(NewOTWriter(bw), runs, opts, minify)
+	misc.DeferError(bw.Flush)
+	return buf.Len() + len(sheet)
+}
+
+// writeRuns writes the output for runs to w, in the format selected by
+// opts. If the stylesheet goes to opts.CSSFile, it is returned rather
+// than written; otherwise the returned sheet is "".
+func writeRuns(w *OTWriter, runs []coloredRun, opts colorOptions, minify bool) (sheet string) {
+	switch {
+	case opts.Format == formatANSI:
+		renderANSI(w, runs, minify)
+	case opts.CSS == cssClasses:
+		return renderClasses(w, runs, opts, minify)
+	default:
+		renderInline(w, runs, opts.Annotate, minify)
+	}
+	return ""
+}
+
+// renderInline writes each run as `<span style="color: ...;">`, all
+// wrapped in an outer `<span>`. Runs without a color (see optimizeRuns)
+// are written as bare text. With annotate, each span gets a title (see
+// titleAttr).
+func renderInline(w *OTWriter, runs []coloredRun, annotate bool, minify bool) {
+	w.WriteString("<span>")
+	for _, run := range runs {
+		text := htmlEscaper.Replace(run.text)
+		if "" == run.fg {
+			w.WriteString(text)
+			continue
+		}
+		if minify {
+			style := "color:" + shortHex(run.fg)
+			if misc.IsStringSet(&run.bg) {
+				style += ";background-color:" + shortHex(run.bg) + ";" + antiPaddingMin
+			}
+			w.WriteString("<span ", minAttr("style", style), titleAttr(run, annotate, true),
+				">", text, "</span>")
+			continue
+		}
+		w.WriteString("<span", titleAttr(run, annotate, false), " style=\"color: ", run.fg)
+		if misc.IsStringSet(&run.bg) {
+			w.WriteString("; ", antiPadding, "; background-color: ", run.bg)
+		}
+		w.WriteString(";\">", text, "</span>")
+	}
+	w.WriteString("</span>\n")
+}
+
+// titleAttr returns the title attribute --annotate adds to the span of
+// a run, with a leading space, or "" without annotate. It names the
+// color by its display name, or for an invented color, by the nearest
+// named color and the perceptual distance to it, such as
+// `nearest: Royal Purple (ΔE 2.1)`.
+func titleAttr(run coloredRun, annotate bool, minify bool) string {
+	if !annotate {
+		return ""
+	}
+	title := htmlColor.DisplayName(run.fgName)
+	if "" == title {
+		// run.fg was picked as "#rrggbb"
+		name, _, dist, _ := htmlColor.NearestColor(run.fg)
+		title = fmt.Sprintf("nearest: %s (ΔE %.1f)", htmlColor.DisplayName(name), dist)
+	}
+	if minify {
+		return " " + minAttr("title", html.EscapeString(title))
+	}
+	return " title=\"" + html.EscapeString(title) + "\""
+}
+
+// cssRule is one class of the generated stylesheet.
+type cssRule struct {
+	class string
+	fg    string
+	bg    string
+}
+
+// format formats the rule as a line of CSS, or with minify, as a
+// rule with no optional whitespace or punctuation.
+func (r cssRule) format(minify bool) string {
+	var sb strings.Builder
+	if minify {
+		sb.WriteString("." + r.class + "{color:" + shortHex(r.fg))
+		if "" != r.bg {
+			sb.WriteString(";background-color:" + shortHex(r.bg) + ";" + antiPaddingMin)
+		}
+		sb.WriteString("}")
+		return sb.String()
+	}
+	sb.WriteString(".")
+	sb.WriteString(r.class)
+	sb.WriteString(" { color: ")
+	sb.WriteString(r.fg)
+	if "" != r.bg {
+		sb.WriteString("; background-color: ")
+		sb.WriteString(r.bg)
+		sb.WriteString("; ")
+		sb.WriteString(antiPadding)
+	}
+	sb.WriteString("; }\n")
+	return sb.String()
+}
+
+// renderClasses writes each run as `<span class="...">` and defines each
+// distinct fg/bg pair once, either in a `<style>` block ahead of the
+// text or, if opts.CSSFile is set, in the returned stylesheet.
+func renderClasses(w *OTWriter, runs []coloredRun, opts colorOptions, minify bool) (sheet string) {
+	var rules []cssRule
+	var sb strings.Builder
+
+	classOf := make(map[[2]string]string, len(runs))
+	taken := make(map[string]bool, len(runs))
+	runClass := make([]string, len(runs))
+
+	for ix, run := range runs {
+		if "" == run.fg {
+			continue
+		}
+		pair := [2]string{run.fg, run.bg}
+		class, ok := classOf[pair]
+		if !ok {
+			class = className(run, opts.ClassPrefix, len(rules))
+			// two different pairs may slug to the same name, or a
+			// name to a number
+			for n := len(rules); taken[class]; n++ {
+				class = opts.ClassPrefix + strconv.Itoa(n)
+			}
+			taken[class] = true
+			classOf[pair] = class
+			rules = append(rules, cssRule{class: class, fg: run.fg, bg: run.bg})
+		}
+		runClass[ix] = class
+	}
+
+	for _, rule := range rules {
+		sb.WriteString(rule.format(minify))
+	}
+
+	if misc.IsStringSet(&opts.CSSFile) {
+		sheet = sb.String()
+	} else if minify {
+		w.WriteString("<style>", sb.String(), "</style>")
+	} else {
+		w.WriteString("<style>\n", sb.String(), "</style>\n")
+	}
+
+	w.WriteString("<span>")
+	for ix, run := range runs {
+		text := htmlEscaper.Replace(run.text)
+		switch {
+		case "" == run.fg:
+			w.WriteString(text)
+		case minify:
+			w.WriteString("<span ", minAttr("class", runClass[ix]),
+				titleAttr(run, opts.Annotate, true), ">", text, "</span>")
+		default:
+			w.WriteString("<span class=\"", runClass[ix], "\"",
+				titleAttr(run, opts.Annotate, false), ">", text, "</span>")
+		}
+	}
+	w.WriteString("</span>\n")
+	return sheet
+}
+
+// ansiReset returns the terminal to its default colors.
+const ansiReset = "\x1b[0m"
+
+// ansiColor returns the 24-bit SGR sequence that sets the foreground
+// (layer 38) or background (layer 48) to a "#rrggbb" color. The colors
+// come from pickColors, which only returns that form, so the RGB error
+// cannot happen.
+func ansiColor(layer int, hex string) string {
+	r, g, b, _ := htmlColor.RGB(hex)
+	return fmt.Sprintf("\x1b[%d;2;%d;%d;%dm", layer, r, g, b)
+}
+
+// renderANSI writes the runs with 24-bit color escape sequences for the
+// terminal, ending with a reset. Runs without a color use the terminal's
+// default. Unless minify is set, every run carries its full sequence;
+// with minify, a sequence is only written when the colors change.
+func renderANSI(w *OTWriter, runs []coloredRun, minify bool) {
+	var last coloredRun
+	for ix, run := range runs {
+		if minify && ix > 0 && run.fg == last.fg && run.bg == last.bg {
+			w.WriteString(run.text)
+			continue
+		}
+		prev := last
+		last = run
+		switch {
+		case "" == run.fg:
+			w.WriteString(ansiReset)
+		case "" == run.bg:
+			if !minify || "" != prev.bg {
+				w.WriteString(ansiReset)
+			}
+			w.WriteString(ansiColor(38, run.fg))
+		default:
+			w.WriteString(ansiColor(38, run.fg), ansiColor(48, run.bg))
+		}
+		w.WriteString(run.text)
+	}
+	w.WriteString(ansiReset, "\n")
+}
+
+// optimizeRuns coalesces runs for --optimize. Whitespace that has no
+// background of its own does not show its color, so it is folded into
+// the preceding run (or, at the start of the text, left without a
+// color); then adjacent runs with identical styling are merged.
+func optimizeRuns(runs []coloredRun) (opt []coloredRun) {
+	for _, run := range runs {
+		if "" == run.bg && "" == strings.TrimSpace(run.text) {
+			if len(opt) > 0 {
+				opt[len(opt)-1].text += run.text
+				continue
+			}
+			run.fg, run.fgName = "", ""
+		}
+		if len(opt) > 0 {
+			last := &opt[len(opt)-1]
+			if last.fg == run.fg && last.bg == run.bg {
+				last.text += run.text
+				continue
+			}
+		}
+		opt = append(opt, run)
+	}
+	return opt
+}
+
+// shortHex lowercases a "#rrggbb" color and, where each pair of digits
+// repeats, shortens it to the equivalent "#rgb".
+func shortHex(hex string) string {
+	hex = strings.ToLower(hex)
+	if len(hex) == 7 && hex[1] == hex[2] && hex[3] == hex[4] && hex[5] == hex[6] {
+		return string([]byte{'#', hex[1], hex[3], hex[5]})
+	}
+	return hex
+}
+
+// minAttr formats an attribute with the minimal syntax HTML allows:
+// unquoted if the value contains nothing that requires quotes.
+func minAttr(name string, value string) string {
+	if "" == value || strings.ContainsAny(value, " \t\n\f\r\"'=<>`") {
+		return name + "=\"" + value + "\""
+	}
+	return name + "=" + value
+}
+
+// className returns the class for a run's fg/bg pair. When the colors
+// were named, the class is derived from the names (for example
+// "mc-alice-blue" or "mc-red-on-navy"); otherwise it is the prefix and
+// the pair's sequence number n ("mc-3"), as it is for a name with no
+// ASCII letters or digits to slug.
+func className(run coloredRun, prefix string, n int) string {
+	fg, bg := classSlug(run.fgName), classSlug(run.bgName)
+	switch {
+	case "" == fg || ("" != run.bg && "" == bg):
+		return prefix + strconv.Itoa(n)
+	case "" == run.bg:
+		return prefix + fg
+	}
+	return fmt.Sprintf("%s%s-on-%s", prefix, fg, bg)
+}
+
+// classSlug lowercases a color name and replaces every run of characters
+// that are not ASCII letters or digits with a single hyphen, so that
+// "Air Force Blue (USAF)" becomes "air-force-blue-usaf".
+func classSlug(name string) string {
+	var sb strings.Builder
+	hyphen := false
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			if hyphen && sb.Len() > 0 {
+				sb.WriteByte('-')
+			}
+			hyphen = false
+			sb.WriteRune(r)
+			continue
+		}
+		hyphen = true
+	}
+	return sb.String()
+}
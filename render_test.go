@@ -0,0 +1,150 @@
+package main
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+)
+
+// renderClassesString renders runs with --css classes and returns the
+// markup.
+func renderClassesString(runs []coloredRun) string {
+	var sb strings.Builder
+	bw := bufio.NewWriter(&sb)
+	opts := defaultColorOptions()
+	opts.CSS = cssClasses
+	renderClasses(NewOTWriter(bw), runs, opts, false)
+	_ = bw.Flush()
+	return sb.String()
+}
+
+// TestRenderClasses checks that each fg/bg pair gets one rule, named
+// after its colors where they have names, and that names which collide
+// or cannot be slugged fall back to numbers.
+func TestRenderClasses(t *testing.T) {
+	runs := []coloredRun{
+		{text: "a", fg: "#f0f8ff", fgName: "alice blue"},
+		{text: "b", fg: "#ff0000", fgName: "red", bg: "#000080", bgName: "navy"},
+		{text: "c", fg: "#f0f8ff", fgName: "alice blue"},
+		{text: " "},
+		{text: "d", fg: "#123456"},
+		{text: "e", fg: "#f0f8fe", fgName: "Alice-Blue"},
+		{text: "f", fg: "#654321", fgName: "☃"},
+		{text: "g", fg: "#ff0000", fgName: "red", bg: "#000081"},
+		{text: "h", fg: "#ff0000", fgName: "red", bg: "#000080", bgName: "navy"},
+	}
+	got := renderClassesString(runs)
+
+	want := []string{
+		".mc-alice-blue { color: #f0f8ff; }",
+		".mc-red-on-navy { color: #ff0000; background-color: #000080;",
+		".mc-2 { color: #123456; }",
+		".mc-3 { color: #f0f8fe; }", // "Alice-Blue" slugs to a name already taken
+		".mc-4 { color: #654321; }", // "☃" has nothing to slug
+		".mc-5 { color: #ff0000; background-color: #000081;",
+		`<span class="mc-alice-blue">a</span><span class="mc-red-on-navy">b</span>` +
+			`<span class="mc-alice-blue">c</span> <span class="mc-2">d</span>`,
+		`<span class="mc-4">f</span><span class="mc-5">g</span><span class="mc-red-on-navy">h</span>`,
+	}
+	for _, w := range want {
+		if !strings.Contains(got, w) {
+			t.Errorf("no %q in\n%s", w, got)
+		}
+	}
+	if n := strings.Count(got, "{"); 6 != n {
+		t.Errorf("%d rules, want one for each of the 6 pairs:\n%s", n, got)
+	}
+	if strings.Contains(got, ".mc- ") || strings.Contains(got, "mc--") {
+		t.Errorf("a class is just the prefix:\n%s", got)
+	}
+}
+
+// TestRenderClassesNumberCollision checks that a numbered class does not
+// take a name a color name already slugged to.
+func TestRenderClassesNumberCollision(t *testing.T) {
+	got := renderClassesString([]coloredRun{
+		{text: "a", fg: "#111111", fgName: "1"},
+		{text: "b", fg: "#222222"},
+	})
+	if !strings.Contains(got, ".mc-1 { color: #111111; }") || !strings.Contains(got, ".mc-2 { color: #222222; }") {
+		t.Errorf("the classes collide:\n%s", got)
+	}
+}
+
+// TestOptimizeRuns checks how --optimize coalesces runs: whitespace with
+// no background joins the run before it (and stays bare at the start),
+// equal neighbours merge, and runs with a background are kept.
+func TestOptimizeRuns(t *testing.T) {
+	red := coloredRun{fg: "#ff0000", fgName: "red"}
+	blue := coloredRun{fg: "#0000ff", fgName: "blue"}
+	on := func(run coloredRun, text string, bg string) coloredRun {
+		run.text, run.bg = text, bg
+		return run
+	}
+
+	got := optimizeRuns([]coloredRun{
+		on(blue, " \n", ""),
+		on(red, "a", ""),
+		on(blue, " ", ""),
+		on(red, "b", ""),
+		on(blue, "c", ""),
+		on(blue, "d", ""),
+		on(red, " ", "#000000"),
+		on(red, "e", "#000000"),
+		on(blue, "f", "#ffffff"),
+	})
+	want := []coloredRun{
+		{text: " \n"},
+		on(red, "a b", ""),
+		on(blue, "cd", ""),
+		on(red, " e", "#000000"),
+		on(blue, "f", "#ffffff"),
+	}
+	if len(want) != len(got) {
+		t.Fatalf("got %d runs, want %d: %+v", len(got), len(want), got)
+	}
+	for ix := range want {
+		if want[ix] != got[ix] {
+			t.Errorf("run %d is %+v, want %+v", ix, got[ix], want[ix])
+		}
+	}
+}
+
+// TestShortHex checks that only colors whose digit pairs repeat are
+// shortened, and that all are lowercased.
+func TestShortHex(t *testing.T) {
+	for hex, want := range map[string]string{
+		"#aabbcc": "#abc",
+		"#AABBCC": "#abc",
+		"#000000": "#000",
+		"#aabbcd": "#aabbcd",
+		"#ABCDEF": "#abcdef",
+		"#abc":    "#abc",
+	} {
+		if got := shortHex(hex); want != got {
+			t.Errorf("shortHex(%q) = %q, want %q", hex, got, want)
+		}
+	}
+}
+
+// TestMinAttr checks that an attribute value (already escaped, as its
+// callers pass it) is quoted only where HTML requires it.
+func TestMinAttr(t *testing.T) {
+	for value, want := range map[string]string{
+		"mc-red":    `class=mc-red`,
+		"":          `class=""`,
+		"a b":       `class="a b"`,
+		"a\tb":      "class=\"a\tb\"",
+		"a&#34;b":   `class=a&#34;b`,
+		"a'b":       `class="a'b"`,
+		"a=b":       `class="a=b"`,
+		"a<b":       `class="a<b"`,
+		"a>b":       `class="a>b"`,
+		"a`b":       "class=\"a`b\"",
+		"red/green": `class=red/green`,
+	} {
+		if got := minAttr("class", value); want != got {
+			t.Errorf("minAttr(%q) = %s, want %s", value, got, want)
+		}
+	}
+}
@@ -0,0 +1,380 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/textproto"
+	"os"
+	"strconv"
+
+	htmlColor "madcolor/htmlcolor"
+)
+
+// JSON-RPC 2.0 error codes
+const (
+	rpcParseError     = -32700
+	rpcInvalidRequest = -32600
+	rpcMethodNotFound = -32601
+	rpcInvalidParams  = -32602
+	rpcInternalError  = -32603
+)
+
+// rpcMaxMessage is the largest message body accepted, in bytes.
+const rpcMaxMessage = 16 << 20
+
+// rpcRequest is a JSON-RPC 2.0 request. A request without an id is a
+// notification, and gets no response.
+type rpcRequest struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+// rpcError is the error member of a JSON-RPC 2.0 response.
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *rpcError) Error() string { return e.Message }
+
+// rpcResponse is a JSON-RPC 2.0 response.
+type rpcResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  any             `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+}
+
+// paletteInfo describes one palette for listPalettes.
+type paletteInfo struct {
+	Name   string                 `json:"name"`
+	Count  int                    `json:"count"`
+	Colors []htmlColor.NamedColor `json:"colors,omitempty"`
+}
+
+// rpcMethods maps each method name to its handler. A handler decodes its
+// own params; an *rpcError it returns is passed on as is.
+var rpcMethods = map[string]func(params json.RawMessage) (any, error){
+	"colorize": func(params json.RawMessage) (any, error) {
+		req := colorizeRequest{colorOptions: defaultColorOptions()}
+		err := decodeParams(params, &req)
+		if nil == err {
+			err = req.validate()
+		}
+		if nil != err {
+			return nil, &rpcError{Code: rpcInvalidParams, Message: err.Error()}
+		}
+		resp, err := colorizeResult(req)
+		switch {
+		case errors.Is(err, htmlColor.ErrUnknownColor),
+			errors.Is(err, htmlColor.ErrContrastUnsatisfiable):
+			return nil, &rpcError{Code: rpcInvalidParams, Message: err.Error()}
+		case nil != err:
+			return nil, err
+		}
+		return resp, nil
+	},
+	"listPalettes": func(params json.RawMessage) (any, error) {
+		var p struct {
+			Colors bool `json:"colors"`
+		}
+		err := decodeParams(params, &p)
+		if nil != err {
+			return nil, &rpcError{Code: rpcInvalidParams, Message: err.Error()}
+		}
+		palette := htmlColor.Palette()
+		info := paletteInfo{Name: "named", Count: len(palette)}
+		if p.Colors {
+			info.Colors = palette
+		}
+		return []paletteInfo{info}, nil
+	},
+	"checkContrast": func(params json.RawMessage) (any, error) {
+		var p struct {
+			Foreground string `json:"fg"`
+			Background string `json:"bg"`
+		}
+		err := decodeParams(params, &p)
+		if nil != err {
+			return nil, &rpcError{Code: rpcInvalidParams, Message: err.Error()}
+		}
+		resp, err := contrastResult(p.Foreground, p.Background)
+		if nil != err {
+			return nil, &rpcError{Code: rpcInvalidParams, Message: err.Error()}
+		}
+		return resp, nil
+	},
+	"nearestName": func(params json.RawMessage) (any, error) {
+		var p struct {
+			Color string `json:"color"`
+		}
+		err := decodeParams(params, &p)
+		if nil != err {
+			return nil, &rpcError{Code: rpcInvalidParams, Message: err.Error()}
+		}
+		resp, err := nearestResult(p.Color)
+		if nil != err {
+			return nil, &rpcError{Code: rpcInvalidParams, Message: err.Error()}
+		}
+		return resp, nil
+	},
+}
+
+// decodeParams decodes by-name params into v. Absent params leave v as is.
+func decodeParams(params json.RawMessage, v any) error {
+	if 0 == len(params) || "null" == string(params) {
+		return nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(params))
+	dec.DisallowUnknownFields()
+	return dec.Decode(v)
+}
+
+// errFraming marks a message readMessage could not delimit: the header
+// is malformed or its Content-Length unusable. The session can go on
+// once the reader is back in step (see resync).
+var errFraming = errors.New("bad message framing")
+
+// contentLength is the header every message starts with.
+const contentLength = "Content-Length:"
+
+// readMessage reads one Content-Length framed message, as used by the
+// Language Server Protocol: header lines, a blank line, then exactly
+// Content-Length bytes of body. It returns io.EOF at a clean end of
+// input, and an error wrapping errFraming for a message it could not
+// delimit.
+func readMessage(tp *textproto.Reader) (body []byte, err error) {
+	header, err := tp.ReadMIMEHeader()
+	if nil != err {
+		if errors.Is(err, io.EOF) && 0 == len(header) {
+			return nil, io.EOF
+		}
+		var pErr textproto.ProtocolError
+		if errors.As(err, &pErr) {
+			return nil, fmt.Errorf("%w: could not read message header because %w", errFraming, err)
+		}
+		return nil, fmt.Errorf("could not read message header because %w", err)
+	}
+	length, err := strconv.Atoi(header.Get("Content-Length"))
+	if nil != err || length < 0 {
+		return nil, fmt.Errorf("%w: bad Content-Length %q", errFraming, header.Get("Content-Length"))
+	}
+	if length > rpcMaxMessage {
+		// skipping the body keeps the reader in step
+		_, err = io.CopyN(io.Discard, tp.R, int64(length))
+		if nil != err {
+			return nil, fmt.Errorf("could not skip message body because %w", err)
+		}
+		return nil, fmt.Errorf("%w: message of %d bytes is larger than %d", errFraming, length, rpcMaxMessage)
+	}
+	body = make([]byte, length)
+	_, err = io.ReadFull(tp.R, body)
+	if nil != err {
+		return nil, fmt.Errorf("could not read message body because %w", err)
+	}
+	return body, nil
+}
+
+// resync discards input up to the next Content-Length header, after a
+// message that could not be delimited. A body may follow its header
+// without a line break, so the header is looked for anywhere, not only
+// at the start of a line. It returns io.EOF if there is none.
+func resync(r *bufio.Reader) error {
+	for {
+		next, err := r.Peek(len(contentLength))
+		if nil != err {
+			return io.EOF
+		}
+		if bytes.EqualFold(next, []byte(contentLength)) {
+			return nil
+		}
+		_, _ = r.Discard(1)
+	}
+}
+
+// writeMessage writes v as a Content-Length framed JSON message.
+func writeMessage(w *bufio.Writer, v any) error {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	err := enc.Encode(v)
+	if nil != err {
+		return err
+	}
+	body := bytes.TrimSuffix(buf.Bytes(), []byte("\n"))
+	_, err = fmt.Fprintf(w, "Content-Length: %d\r\n\r\n", len(body))
+	if nil == err {
+		_, err = w.Write(body)
+	}
+	if nil == err {
+		err = w.Flush()
+	}
+	return err
+}
+
+// rpcErrorResponse returns a response with no id carrying an error.
+func rpcErrorResponse(code int, message string) *rpcResponse {
+	return &rpcResponse{JSONRPC: "2.0", ID: json.RawMessage("null"),
+		Error: &rpcError{Code: code, Message: message}}
+}
+
+// handleRPC answers one message: a request, or a batch of them (a JSON
+// array), which is answered with an array of the responses. It returns
+// nil when there is nothing to answer (notifications only).
+func handleRPC(body []byte) any {
+	trimmed := bytes.TrimLeft(body, " \t\r\n")
+	if 0 == len(trimmed) || '[' != trimmed[0] {
+		resp := handleRequest(body)
+		if nil == resp {
+			return nil // not a nil *rpcResponse in an any
+		}
+		return resp
+	}
+
+	var batch []json.RawMessage
+	err := json.Unmarshal(body, &batch)
+	if nil != err {
+		return rpcErrorResponse(rpcParseError, err.Error())
+	}
+	if 0 == len(batch) {
+		return rpcErrorResponse(rpcInvalidRequest, "a batch needs at least one request")
+	}
+	var resps []*rpcResponse
+	for _, raw := range batch {
+		if resp := handleRequest(raw); nil != resp {
+			resps = append(resps, resp)
+		}
+	}
+	if 0 == len(resps) {
+		return nil
+	}
+	return resps
+}
+
+// handleRequest answers one request. It returns nil for notifications.
+func handleRequest(body []byte) *rpcResponse {
+	var req rpcRequest
+
+	err := json.Unmarshal(body, &req)
+	if nil != err {
+		if json.Valid(body) {
+			// JSON, but not a request object, such as a batch member 1
+			return rpcErrorResponse(rpcInvalidRequest, err.Error())
+		}
+		return rpcErrorResponse(rpcParseError, err.Error())
+	}
+	resp := &rpcResponse{JSONRPC: "2.0", ID: req.ID}
+	if 0 == len(req.ID) {
+		resp.ID = json.RawMessage("null")
+	}
+	if "2.0" != req.JSONRPC || "" == req.Method {
+		resp.Error = &rpcError{Code: rpcInvalidRequest,
+			Message: `a request needs "jsonrpc": "2.0" and a method`}
+		return resp
+	}
+
+	method, ok := rpcMethods[req.Method]
+	if !ok {
+		resp.Error = &rpcError{Code: rpcMethodNotFound,
+			Message: fmt.Sprintf("no method %q", req.Method)}
+	} else {
+		var rErr *rpcError
+		resp.Result, err = callMethod(req.Method, method, req.Params)
+		switch {
+		case errors.As(err, &rErr):
+			resp.Error = rErr
+		case nil != err:
+			resp.Error = &rpcError{Code: rpcInternalError, Message: err.Error()}
+		}
+	}
+
+	if 0 == len(req.ID) {
+		return nil // notification
+	}
+	return resp
+}
+
+// callMethod calls a handler, turning a panic into an internal error
+// and an error in the log: the server lives as long as the editor does,
+// and one bad request must not end it.
+func callMethod(name string, method func(json.RawMessage) (any, error),
+	params json.RawMessage) (result any, err error) {
+	defer func() {
+		p := recover()
+		if nil != p {
+			xLog.Error("an rpc handler panicked", "method", name, "panic", p)
+			result, err = nil, &rpcError{Code: rpcInternalError, Message: "internal error"}
+		}
+	}()
+	return method(params)
+}
+
+// serveRPC reads requests from in and writes responses to out until in
+// is exhausted. A message that cannot be delimited is answered with a
+// parse error, and the input skipped up to the next message.
+func serveRPC(in io.Reader, out io.Writer) error {
+	tp := textproto.NewReader(bufio.NewReader(in))
+	bw := bufio.NewWriter(out)
+	for {
+		var resp any
+		body, err := readMessage(tp)
+		switch {
+		case errors.Is(err, io.EOF):
+			return nil
+		case errors.Is(err, errFraming):
+			xLog.Warn("skipping a malformed rpc message", "err", err)
+			resp = rpcErrorResponse(rpcParseError, err.Error())
+			err = resync(tp.R)
+		case nil != err:
+			return err
+		default:
+			resp = handleRPC(body)
+		}
+
+		if nil != resp {
+			wErr := writeMessage(bw, resp)
+			if nil != wErr {
+				return fmt.Errorf("could not write response because %w", wErr)
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+	}
+}
+
+// newRPCFlags returns the flag set of the rpc command.
+func newRPCFlags() *commandFlags {
+	rFlags := newCommandFlags("rpc")
+	addPaletteSourceFlag(rFlags.FlagSet)
+	return rFlags
+}
+
+// runRPC implements `madcolor rpc`: a long-lived JSON-RPC 2.0 server on
+// stdin and stdout, for editor integrations. stdout carries the
+// protocol; the log never goes there.
+func runRPC(args []string) error {
+	rFlags := newRPCFlags()
+	args, err := parseCommandFlags(rFlags, args)
+	if nil == err {
+		err = requireArgs(rFlags, args, 0, 0)
+	}
+	if nil == err {
+		err = setPaletteSources()
+	}
+	if nil != err {
+		return err
+	}
+
+	err = serveRPC(os.Stdin, os.Stdout)
+	if nil != err {
+		return fmt.Errorf("the rpc session ended: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/textproto"
+	"strings"
+	"testing"
+)
+
+// frame returns body as a Content-Length framed message.
+func frame(body string) string {
+	return fmt.Sprintf("Content-Length: %d\r\n\r\n%s", len(body), body)
+}
+
+// rpcSession runs serveRPC over input and returns the bodies of the
+// messages it wrote.
+func rpcSession(t *testing.T, input string) []string {
+	t.Helper()
+	var out bytes.Buffer
+	err := serveRPC(strings.NewReader(input), &out)
+	if nil != err {
+		t.Fatalf("serveRPC: %v", err)
+	}
+
+	var bodies []string
+	tp := textproto.NewReader(bufio.NewReader(&out))
+	for {
+		body, err := readMessage(tp)
+		if errors.Is(err, io.EOF) {
+			return bodies
+		}
+		if nil != err {
+			t.Fatalf("could not read a response: %v", err)
+		}
+		bodies = append(bodies, string(body))
+	}
+}
+
+// TestRPCBatch checks that a batch is answered with an array of the
+// responses, notifications left out, and that bad members get their
+// own errors.
+func TestRPCBatch(t *testing.T) {
+	isolate(t)
+	bodies := rpcSession(t, frame(`[`+
+		`{"jsonrpc": "2.0", "id": 1, "method": "nearestName", "params": {"color": "#ff0000"}},`+
+		`{"jsonrpc": "2.0", "method": "nearestName", "params": {"color": "red"}},`+
+		`1,`+
+		`{"jsonrpc": "2.0", "id": 2, "method": "nosuchmethod"}]`)+
+		frame(`[{"jsonrpc": "2.0", "method": "listPalettes"}]`)+
+		frame(`[]`))
+	if 2 != len(bodies) {
+		t.Fatalf("got %d responses, want 2: %q", len(bodies), bodies)
+	}
+
+	var batch []rpcResponse
+	err := json.Unmarshal([]byte(bodies[0]), &batch)
+	if nil != err {
+		t.Fatalf("the batch response %s: %v", bodies[0], err)
+	}
+	codes := make([]int, len(batch))
+	for ix, resp := range batch {
+		if nil != resp.Error {
+			codes[ix] = resp.Error.Code
+		}
+	}
+	if fmt.Sprint([]int{0, rpcInvalidRequest, rpcMethodNotFound}) != fmt.Sprint(codes) {
+		t.Errorf("batch response codes %v in %s", codes, bodies[0])
+	}
+
+	var resp rpcResponse
+	err = json.Unmarshal([]byte(bodies[1]), &resp)
+	if nil != err || nil == resp.Error || rpcInvalidRequest != resp.Error.Code {
+		t.Errorf("an empty batch gave %s", bodies[1])
+	}
+}
+
+// TestRPCResync checks that a malformed message is answered with a
+// parse error and that the messages after it are still served.
+func TestRPCResync(t *testing.T) {
+	isolate(t)
+	defer func(l *slog.Logger) { xLog = l }(xLog)
+	xLog = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	request := `{"jsonrpc": "2.0", "id": 7, "method": "listPalettes"}`
+	cases := []struct {
+		name string
+		bad  string
+	}{
+		{"bad length", "Content-Length: x\r\n\r\n{}"},
+		{"no length", "Content-Type: x\r\n\r\n{}"},
+		{"bad header", "garbage\r\n\r\n{}"},
+		{"too large", fmt.Sprintf("Content-Length: %d\r\n\r\n%s", rpcMaxMessage+1,
+			strings.Repeat(" ", rpcMaxMessage+1))},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			bodies := rpcSession(t, c.bad+frame(request))
+			if 2 != len(bodies) {
+				t.Fatalf("got %d responses, want 2: %q", len(bodies), bodies)
+			}
+			var resp rpcResponse
+			err := json.Unmarshal([]byte(bodies[0]), &resp)
+			if nil != err || nil == resp.Error || rpcParseError != resp.Error.Code {
+				t.Errorf("the malformed message gave %s", bodies[0])
+			}
+			var next rpcResponse
+			err = json.Unmarshal([]byte(bodies[1]), &next)
+			if nil != err || nil != next.Error || "7" != string(next.ID) {
+				t.Errorf("the request after it gave %s", bodies[1])
+			}
+		})
+	}
+}
+
+// TestRPCRecover checks that a handler that panics gets an internal
+// error, and that the session goes on.
+func TestRPCRecover(t *testing.T) {
+	isolate(t)
+	defer func(l *slog.Logger) { xLog = l }(xLog)
+	xLog = slog.New(slog.NewTextHandler(io.Discard, nil))
+	rpcMethods["panic"] = func(json.RawMessage) (any, error) { panic("huh?") }
+	defer delete(rpcMethods, "panic")
+
+	bodies := rpcSession(t, frame(`{"jsonrpc": "2.0", "id": 1, "method": "panic"}`)+
+		frame(`{"jsonrpc": "2.0", "id": 2, "method": "listPalettes"}`))
+	if 2 != len(bodies) {
+		t.Fatalf("got %d responses, want 2: %q", len(bodies), bodies)
+	}
+	var resp rpcResponse
+	err := json.Unmarshal([]byte(bodies[0]), &resp)
+	if nil != err || nil == resp.Error || rpcInternalError != resp.Error.Code {
+		t.Errorf("the panicking handler gave %s", bodies[0])
+	}
+	var next rpcResponse
+	err = json.Unmarshal([]byte(bodies[1]), &next)
+	if nil != err || nil != next.Error {
+		t.Errorf("the request after it gave %s", bodies[1])
+	}
+}
@@ -0,0 +1,377 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"html"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	htmlColor "madcolor/htmlcolor"
+	"madcolor/misc"
+)
+
+var FlagPaletteJSON bool
+var FlagPaletteHTML bool
+var FlagSwatches bool
+var FlagNear string
+var FlagMaxDeltaE float64
+var FlagHue string
+var FlagLightness string
+var FlagContrastAgainst string
+var FlagMinContrast string
+var FlagLimit int
+
+// hueNames are the hue ranges, in degrees, that --hue accepts by name.
+// A range whose start is above its end wraps through 0.
+var hueNames = map[string][2]float64{
+	"red":     {345, 15},
+	"orange":  {15, 45},
+	"yellow":  {45, 70},
+	"green":   {70, 165},
+	"cyan":    {165, 195},
+	"blue":    {195, 255},
+	"purple":  {255, 290},
+	"magenta": {290, 345},
+}
+
+// minHueSaturation is the saturation below which a color is taken to
+// be a gray, whose hue is meaningless, so that --hue does not match it.
+const minHueSaturation = 0.08
+
+// swatchWidth is the width of an ANSI swatch, in cells.
+const swatchWidth = 6
+
+// paletteMatch is one color found by searchPalette, with the measures
+// that ordered or filtered it.
+type paletteMatch struct {
+	htmlColor.NamedColor
+	Score  int      `json:"-"`
+	DeltaE *float64 `json:"delta-e,omitempty"`
+	Ratio  *float64 `json:"ratio,omitempty"`
+}
+
+// colorFilter is a predicate on palette colors, set up from the flags.
+type colorFilter func(m *paletteMatch) bool
+
+// addSearchFlags adds the flags of `palette list` and `palette search`.
+func addSearchFlags(fs *commandFlags) {
+	hues := make([]string, 0, len(hueNames))
+	for name := range hueNames {
+		hues = append(hues, name)
+	}
+	sort.Strings(hues)
+
+	fs.BoolVarP(&FlagPaletteJSON, "json", "j", false,
+		"Write list and search results as JSON")
+	fs.BoolVarP(&FlagPaletteHTML, "html", "", false,
+		"Write list and search results as an HTML page of swatches")
+	fs.BoolVarP(&FlagSwatches, "swatches", "", true,
+		"Show an ANSI color swatch by each color (off by default when stdout is not a terminal)")
+	fs.StringVarP(&FlagNear, "near", "", "",
+		"Only colors near this color, closest first (see --max-delta-e)")
+	fs.Float64VarP(&FlagMaxDeltaE, "max-delta-e", "", 10,
+		"With --near, the largest perceptual distance (ΔE) to include")
+	fs.StringVarP(&FlagHue, "hue", "", "",
+		"Only colors with a hue in this range of degrees (e.g. 200-260) or of this name: "+strings.Join(hues, ", "))
+	fs.StringVarP(&FlagLightness, "lightness", "", "",
+		"Only colors with an HSL lightness in this range of percent (e.g. 20-50)")
+	fs.StringVarP(&FlagContrastAgainst, "min-contrast-against", "", "",
+		"Only colors that meet --min-contrast against this background")
+	fs.StringVarP(&FlagMinContrast, "min-contrast", "", "AA",
+		"With --min-contrast-against, the WCAG level (AA, AA-large, AAA, AAA-large) or ratio to meet")
+	fs.IntVarP(&FlagLimit, "limit", "n", 0,
+		"Show at most this many colors (0 for all)")
+	completeFlag(fs.FlagSet, "near", completeColor)
+	completeFlag(fs.FlagSet, "min-contrast-against", completeColor)
+	completeFlag(fs.FlagSet, "min-contrast", completeChoice, wcagLevelNames()...)
+	completeFlag(fs.FlagSet, "hue", completeChoice, hues...)
+}
+
+// searchPalette returns the colors of palette that match query (fuzzily,
+// see fuzzyScore; "" matches every color) and pass the filters set by
+// the flags, best match first, or with --near, nearest first. Bad filter
+// values are an ErrUsage.
+func searchPalette(fs *commandFlags, palette []htmlColor.NamedColor, query string) ([]paletteMatch, error) {
+	filters, err := searchFilters()
+	if nil != err {
+		commandUsage(fs)
+		return nil, usageErrorf("%w", err)
+	}
+
+	var found []paletteMatch
+	for _, c := range palette {
+		m := paletteMatch{NamedColor: c, Score: fuzzyScore(c.Name, c.Hex, query)}
+		if 0 == m.Score {
+			continue
+		}
+		keep := true
+		for _, f := range filters {
+			keep = keep && f(&m)
+		}
+		if keep {
+			found = append(found, m)
+		}
+	}
+
+	sort.SliceStable(found, func(i, j int) bool {
+		if nil != found[i].DeltaE {
+			return *found[i].DeltaE < *found[j].DeltaE
+		}
+		return found[i].Score > found[j].Score
+	})
+	if 0 < FlagLimit && len(found) > FlagLimit {
+		found = found[:FlagLimit]
+	}
+	return found, nil
+}
+
+// searchFilters returns the filters set by the flags.
+func searchFilters() (filters []colorFilter, err error) {
+	if misc.IsStringSet(&FlagNear) {
+		near, err := htmlColor.ParseColor(FlagNear)
+		if nil != err {
+			return nil, fmt.Errorf("--near: %w", err)
+		}
+		filters = append(filters, func(m *paletteMatch) bool {
+			d, err := htmlColor.DeltaE(near, m.Hex)
+			if nil != err {
+				return false
+			}
+			m.DeltaE = &d
+			return d <= FlagMaxDeltaE
+		})
+	}
+
+	if misc.IsStringSet(&FlagHue) {
+		hues, ok := hueNames[strings.ToLower(FlagHue)]
+		if !ok {
+			lo, hi, err := parseRange(FlagHue, 0, 360)
+			if nil != err {
+				return nil, fmt.Errorf("--hue must be a range of degrees or a hue name: %w", err)
+			}
+			hues = [2]float64{lo, hi}
+		}
+		filters = append(filters, func(m *paletteMatch) bool {
+			h, s, _, err := htmlColor.HSL(m.Hex)
+			if nil != err || s < minHueSaturation {
+				return false
+			}
+			if hues[0] > hues[1] { // wraps through red
+				return h >= hues[0] || h <= hues[1]
+			}
+			return h >= hues[0] && h <= hues[1]
+		})
+	}
+
+	if misc.IsStringSet(&FlagLightness) {
+		lo, hi, err := parseRange(FlagLightness, 0, 100)
+		if nil != err {
+			return nil, fmt.Errorf("--lightness must be a range of percent: %w", err)
+		}
+		if lo > hi {
+			return nil, fmt.Errorf("--lightness range %q is backwards", FlagLightness)
+		}
+		filters = append(filters, func(m *paletteMatch) bool {
+			_, _, l, err := htmlColor.HSL(m.Hex)
+			return nil == err && l*100.0 >= lo && l*100.0 <= hi
+		})
+	}
+
+	if misc.IsStringSet(&FlagContrastAgainst) {
+		bg, err := htmlColor.ParseColor(FlagContrastAgainst)
+		if nil != err {
+			return nil, fmt.Errorf("--min-contrast-against: %w", err)
+		}
+		_, ratio, err := parseContrastLevel(FlagMinContrast)
+		if nil != err {
+			return nil, err
+		}
+		filters = append(filters, func(m *paletteMatch) bool {
+			r, err := htmlColor.ContrastRatio(m.Hex, bg)
+			if nil != err {
+				return false
+			}
+			m.Ratio = &r
+			return r >= ratio
+		})
+	}
+	return filters, nil
+}
+
+// parseRange parses "lo-hi" (or a single number, for a range of one)
+// with both ends between floor and ceiling.
+func parseRange(s string, floor float64, ceiling float64) (lo float64, hi float64, err error) {
+	loS, hiS, found := strings.Cut(strings.TrimSpace(s), "-")
+	if !found {
+		hiS = loS
+	}
+	lo, err = strconv.ParseFloat(strings.TrimSpace(loS), 64)
+	if nil == err {
+		hi, err = strconv.ParseFloat(strings.TrimSpace(hiS), 64)
+	}
+	if nil != err || lo < floor || lo > ceiling || hi < floor || hi > ceiling {
+		return 0, 0, fmt.Errorf("%q is not a range from %g to %g such as %g-%g", s, floor, ceiling, floor, ceiling)
+	}
+	return lo, hi, nil
+}
+
+// fuzzyScore scores how well a color matches a search query, from 0
+// (no match) up; the case, spaces and punctuation of both are ignored.
+// In order of preference, the query may be the whole name, its start,
+// a part of it, or the hex value; its letters may appear in the name in
+// order with gaps ("rblue" finds "royal blue"); or each of its words may
+// be a word of the name with a typo or two ("lavendar").
+func fuzzyScore(name string, hex string, query string) int {
+	n := rxNotSlug.ReplaceAllString(strings.ToLower(name), "")
+	q := rxNotSlug.ReplaceAllString(strings.ToLower(query), "")
+	extra := len(n) - len(q)
+
+	switch {
+	case "" == q:
+		return 1
+	case n == q:
+		return 1000
+	case strings.HasPrefix(n, q):
+		return max(900-extra, 801)
+	case strings.Contains(n, q):
+		return max(800-extra, 701)
+	case strings.Contains(hex, strings.TrimPrefix(strings.ToLower(query), "#")):
+		return 700
+	}
+
+	// the letters of the query in order, fewest gaps first
+	gaps, at := 0, 0
+	for ix := 0; ix < len(q); ix++ {
+		found := strings.IndexByte(n[at:], q[ix])
+		if found < 0 {
+			gaps = -1
+			break
+		}
+		if 0 < found && 0 < ix {
+			gaps++
+		}
+		at += found + 1
+	}
+	if 0 <= gaps {
+		return max(600-20*gaps-extra, 401)
+	}
+
+	// every query word near a word of the name
+	words := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9')
+	})
+	total := 0
+	queryWords := strings.Fields(strings.ToLower(query))
+	for _, qw := range queryWords {
+		qw = rxNotSlug.ReplaceAllString(qw, "")
+		best := math.MaxInt
+		for _, w := range words {
+			best = min(best, editDistance(qw, w))
+		}
+		if best > len(qw)/4 {
+			return 0
+		}
+		total += best
+	}
+	return max(300-50*total-(len(words)-len(queryWords)), 1)
+}
+
+// editDistance returns the Levenshtein distance between two strings of
+// ASCII letters and digits.
+func editDistance(a string, b string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for jx := range prev {
+		prev[jx] = jx
+	}
+	for ix := 1; ix <= len(a); ix++ {
+		cur[0] = ix
+		for jx := 1; jx <= len(b); jx++ {
+			cost := 1
+			if a[ix-1] == b[jx-1] {
+				cost = 0
+			}
+			cur[jx] = min(prev[jx]+1, cur[jx-1]+1, prev[jx-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
+
+// isTerminal reports whether f is a terminal (a character device).
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return nil == err && 0 != info.Mode()&os.ModeCharDevice
+}
+
+// printMatches writes the colors found by list or search: as JSON with
+// --json, as an HTML swatch page with --html, and otherwise as a table
+// with an ANSI swatch by each color (unless --swatches is off, or stdout
+// is not a terminal and --swatches was not given).
+func printMatches(fs *commandFlags, found []paletteMatch) error {
+	switch {
+	case FlagPaletteJSON:
+		if nil == found {
+			found = []paletteMatch{}
+		}
+		return printJSON(found)
+	case FlagPaletteHTML:
+		return writeSwatchPage(found)
+	}
+
+	swatches := FlagSwatches && (fs.Changed("swatches") || isTerminal(os.Stdout))
+	out := bufio.NewWriter(os.Stdout)
+	for _, m := range found {
+		if swatches {
+			_, _ = out.WriteString(ansiColor(48, m.Hex) + strings.Repeat(" ", swatchWidth) + ansiReset + "  ")
+		}
+		_, _ = fmt.Fprintf(out, "%s  %s", m.Hex, m.Display)
+		if nil != m.DeltaE {
+			_, _ = fmt.Fprintf(out, "  (ΔE %.1f)", *m.DeltaE)
+		}
+		if nil != m.Ratio {
+			_, _ = fmt.Fprintf(out, "  (%.2f:1)", *m.Ratio)
+		}
+		_, _ = out.WriteString("\n")
+	}
+	return flushOutput(out)
+}
+
+// writeSwatchPage writes a standalone HTML page with a swatch for each
+// color, labelled in black or white, whichever contrasts more.
+func writeSwatchPage(found []paletteMatch) error {
+	out := bufio.NewWriter(os.Stdout)
+
+	_, _ = out.WriteString(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>madcolor palette</title>
+<style>
+body { font-family: sans-serif; margin: 1em; }
+.swatches { display: grid; grid-template-columns: repeat(auto-fill, minmax(10em, 1fr)); gap: 0.5em; }
+.swatch { padding: 2.5em 0.5em 0.5em; border-radius: 4px; font-size: 0.85em; }
+.swatch span { display: block; }
+</style>
+</head>
+<body>
+`)
+	_, _ = fmt.Fprintf(out, "<p>%d color(s)</p>\n<div class=\"swatches\">\n", len(found))
+	for _, m := range found {
+		label := "#000000"
+		onWhite, errW := htmlColor.ContrastRatio("#ffffff", m.Hex)
+		onBlack, errB := htmlColor.ContrastRatio("#000000", m.Hex)
+		if nil == errW && nil == errB && onWhite > onBlack {
+			label = "#ffffff"
+		}
+		_, _ = fmt.Fprintf(out,
+			"<div class=\"swatch\" style=\"background-color: %s; color: %s;\"><span>%s</span><span>%s</span></div>\n",
+			m.Hex, label, html.EscapeString(m.Display), m.Hex)
+	}
+	_, _ = out.WriteString("</div>\n</body>\n</html>\n")
+	return flushOutput(out)
+}
@@ -0,0 +1,197 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	htmlColor "madcolor/htmlcolor"
+)
+
+var FlagListen string
+var FlagMaxBody int64
+
+// shutdownGrace is how long in-flight requests get to finish after
+// SIGINT or SIGTERM.
+const shutdownGrace = 5 * time.Second
+
+// colorServer serves the colorize pipeline over HTTP.
+type colorServer struct {
+	maxBody int64
+}
+
+// routes returns the handler for all endpoints, for use by
+// http.Server or httptest.
+func (cs colorServer) routes() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /colorize", cs.colorize)
+	mux.HandleFunc("GET /palette", cs.palette)
+	mux.HandleFunc("GET /contrast", cs.contrast)
+	return recoverPanics(mux)
+}
+
+// colorize handles POST /colorize.
+func (cs colorServer) colorize(w http.ResponseWriter, r *http.Request) {
+	var tooBig *http.MaxBytesError
+
+	req := colorizeRequest{colorOptions: defaultColorOptions()}
+	dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, cs.maxBody))
+	dec.DisallowUnknownFields()
+	err := dec.Decode(&req)
+	switch {
+	case errors.As(err, &tooBig):
+		writeError(w, http.StatusRequestEntityTooLarge,
+			fmt.Errorf("request body is larger than %d bytes", tooBig.Limit))
+		return
+	case nil != err:
+		writeError(w, http.StatusBadRequest, fmt.Errorf("could not decode request: %w", err))
+		return
+	}
+
+	err = req.validate()
+	if nil != err {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	resp, err := colorizeResult(req)
+	switch {
+	case errors.Is(err, htmlColor.ErrUnknownColor):
+		writeError(w, http.StatusBadRequest, err)
+	case errors.Is(err, htmlColor.ErrContrastUnsatisfiable):
+		writeError(w, http.StatusUnprocessableEntity, err)
+	case nil != err:
+		writeError(w, http.StatusInternalServerError, err)
+	default:
+		writeJSON(w, http.StatusOK, resp)
+	}
+}
+
+// palette handles GET /palette.
+func (cs colorServer) palette(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, htmlColor.Palette())
+}
+
+// contrast handles GET /contrast?fg=&bg=. Both colors take any syntax
+// --background-color accepts.
+func (cs colorServer) contrast(w http.ResponseWriter, r *http.Request) {
+	resp, err := contrastResult(r.URL.Query().Get("fg"), r.URL.Query().Get("bg"))
+	if nil != err {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// writeJSON writes v as the JSON response body.
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false) // the output is markup; keep it readable
+	err := enc.Encode(v)
+	if nil != err {
+		xLog.Error("Could not write the response", "err", err)
+	}
+}
+
+// writeError writes err as a JSON error object.
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
+
+// recoverPanics turns a panic in a handler into a 500 response and an
+// error in the log, instead of a dropped connection (net/http recovers
+// too, but silently as far as the client is concerned).
+func recoverPanics(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			p := recover()
+			if nil == p {
+				return
+			}
+			if http.ErrAbortHandler == p {
+				panic(p)
+			}
+			xLog.Error("a handler panicked", "method", r.Method, "path", r.URL.Path, "panic", p)
+			writeError(w, http.StatusInternalServerError, errors.New("internal error"))
+		}()
+		next.ServeHTTP(w, r)
+	})
+}
+
+// logRequests logs each request at the info level.
+func logRequests(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		xLog.Info("request", "method", r.Method, "path", r.URL.Path, "remote", r.RemoteAddr)
+		next.ServeHTTP(w, r)
+	})
+}
+
+// newServeFlags returns the flag set of the serve command.
+func newServeFlags() *commandFlags {
+	sFlags := newCommandFlags("serve")
+
+	sFlags.StringVarP(&FlagListen, "listen", "l", "127.0.0.1:8080",
+		"Address to listen on")
+	sFlags.Int64VarP(&FlagMaxBody, "max-body", "", 1<<20,
+		"Largest request body accepted, in bytes")
+	addPaletteSourceFlag(sFlags.FlagSet)
+	return sFlags
+}
+
+// runServe implements `madcolor serve`: a local HTTP server with a JSON
+// API to the colorize pipeline. It runs until SIGINT or SIGTERM, then
+// shuts down gracefully. An address it cannot listen on is an error.
+func runServe(args []string) error {
+	sFlags := newServeFlags()
+
+	args, err := parseCommandFlags(sFlags, args)
+	if nil == err {
+		err = requireArgs(sFlags, args, 0, 0)
+	}
+	if nil == err {
+		err = setPaletteSources()
+	}
+	if nil != err {
+		return err
+	}
+
+	srv := &http.Server{
+		Addr:              FlagListen,
+		Handler:           logRequests(colorServer{maxBody: FlagMaxBody}.routes()),
+		ReadHeaderTimeout: 10 * time.Second,
+		ReadTimeout:       30 * time.Second,
+		WriteTimeout:      30 * time.Second,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- srv.ListenAndServe()
+	}()
+	xLog.Info("serving", "url", "http://"+FlagListen)
+
+	select {
+	case err = <-serveErr:
+		return fmt.Errorf("could not serve on %s: %w", FlagListen, err)
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownGrace)
+	defer cancel()
+	err = srv.Shutdown(shutdownCtx)
+	if nil != err {
+		xLog.Warn("the server did not shut down cleanly", "err", err)
+	}
+	xLog.Info("stopped serving", "listen", FlagListen)
+	return nil
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	htmlColor "madcolor/htmlcolor"
+)
+
+// request sends a request to the serve endpoints and decodes the JSON
+// response into v, returning the status.
+func request(t *testing.T, h http.Handler, method, target, body string, v any) int {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(method, target, strings.NewReader(body)))
+	if ct := rec.Header().Get("Content-Type"); "application/json" != ct {
+		t.Fatalf("%s %s: Content-Type %q", method, target, ct)
+	}
+	err := json.Unmarshal(rec.Body.Bytes(), v)
+	if nil != err {
+		t.Fatalf("%s %s: could not decode %q: %v", method, target, rec.Body.String(), err)
+	}
+	return rec.Code
+}
+
+func TestServeColorize(t *testing.T) {
+	isolate(t)
+	h := colorServer{maxBody: 1 << 10}.routes()
+
+	var resp colorizeResponse
+	code := request(t, h, http.MethodPost, "/colorize",
+		`{"text": "a<b", "unit": "word", "background-color": "black"}`, &resp)
+	if http.StatusOK != code {
+		t.Fatalf("status %d", code)
+	}
+	if 1 != resp.Units || 1 != len(resp.Colors) || formatHTML != resp.Format ||
+		!strings.Contains(resp.Output, "a&lt;b") {
+		t.Errorf("unexpected response %+v", resp)
+	}
+
+	cases := []struct {
+		name string
+		body string
+		code int
+	}{
+		{"bad json", `{"text": `, http.StatusBadRequest},
+		{"unknown field", `{"text": "x", "bogus": 1}`, http.StatusBadRequest},
+		{"bad option", `{"text": "x", "unit": "line"}`, http.StatusBadRequest},
+		{"unknown color", `{"text": "x", "background-color": "nosuchcolor"}`, http.StatusBadRequest},
+		{"unsatisfiable", `{"text": "x", "background-color": "gray", "contrast": 95,
+			"on-unsatisfiable": "fail"}`, http.StatusUnprocessableEntity},
+		{"too large", `{"text": "` + strings.Repeat("x", 2<<10) + `"}`, http.StatusRequestEntityTooLarge},
+	}
+	for _, c := range cases {
+		var e map[string]string
+		code := request(t, h, http.MethodPost, "/colorize", c.body, &e)
+		if c.code != code || "" == e["error"] {
+			t.Errorf("%s: status %d, %v, want %d and an error", c.name, code, e, c.code)
+		}
+	}
+}
+
+func TestServePalette(t *testing.T) {
+	var palette []htmlColor.NamedColor
+	code := request(t, colorServer{}.routes(), http.MethodGet, "/palette", "", &palette)
+	if http.StatusOK != code || len(htmlColor.Palette()) != len(palette) || 0 == len(palette) {
+		t.Errorf("status %d, %d colors", code, len(palette))
+	}
+}
+
+func TestServeContrast(t *testing.T) {
+	h := colorServer{}.routes()
+
+	var resp contrastResponse
+	code := request(t, h, http.MethodGet, "/contrast?fg=black&bg=white", "", &resp)
+	if http.StatusOK != code || 21 != resp.Ratio || !resp.WCAG["AAA"] {
+		t.Errorf("status %d, %+v", code, resp)
+	}
+
+	var e map[string]string
+	code = request(t, h, http.MethodGet, "/contrast?fg=nosuchcolor&bg=white", "", &e)
+	if http.StatusBadRequest != code || "" == e["error"] {
+		t.Errorf("status %d, %v for an unknown color", code, e)
+	}
+}
+
+func TestServeRecover(t *testing.T) {
+	defer func(l *slog.Logger) { xLog = l }(xLog)
+	xLog = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	h := recoverPanics(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		panic("huh?")
+	}))
+	var e map[string]string
+	code := request(t, h, http.MethodGet, "/", "", &e)
+	if http.StatusInternalServerError != code || "" == e["error"] {
+		t.Errorf("status %d, %v for a panic", code, e)
+	}
+}
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"io"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+
+	"madcolor/misc"
+)
+
+var FlagStripFormat string
+var FlagStripOutput string
+
+// strip input formats (see madcolor strip --format)
+const stripAuto = "auto"
+
+var stripFormats = []string{stripAuto, formatHTML, formatANSI}
+
+// rxANSI matches the terminal escape sequences: CSI sequences such as
+// the SGR colors renderANSI writes, OSC sequences (ended by BEL or ST),
+// and the other two-byte escapes.
+var rxANSI = regexp.MustCompile("\x1b(?:\\[[0-?]*[ -/]*[@-~]|\\][^\x07\x1b]*(?:\x07|\x1b\\\\)|[@-Z\\\\-_])")
+
+// newStripFlags returns the flag set of the strip command.
+func newStripFlags() *commandFlags {
+	sFlags := newCommandFlags("strip")
+
+	sFlags.StringVarP(&FlagStripFormat, "format", "f", stripAuto,
+		fmt.Sprintf("Format of the input, one of %v; auto looks for escape sequences, then tags", stripFormats))
+	sFlags.StringVarP(&FlagStripOutput, "output", "o", "",
+		"Write the text to this file rather than stdout")
+	completeFlag(sFlags.FlagSet, "format", completeChoice, stripFormats...)
+	completeFlag(sFlags.FlagSet, "output", completeFile)
+	return sFlags
+}
+
+// runStrip implements `madcolor strip [file ...]`: it recovers the text
+// that was colorized from madcolor output, in any format colorize
+// writes, reading the files (or stdin) and writing the text to stdout.
+func runStrip(args []string) error {
+	var in bytes.Buffer
+
+	sFlags := newStripFlags()
+
+	args, err := parseCommandFlags(sFlags, args)
+	if nil != err {
+		return err
+	}
+	if !slices.Contains(stripFormats, FlagStripFormat) {
+		return usageErrorf("--format must be one of %v, not %q", stripFormats, FlagStripFormat)
+	}
+
+	if 0 == len(args) {
+		args = []string{"-"}
+	}
+	for _, file := range args {
+		b, err := readMarkup(file)
+		if nil != err {
+			return fmt.Errorf("%w %s: %w", ErrInput, file, err)
+		}
+		in.Write(b)
+	}
+
+	text := stripColor(in.String(), FlagStripFormat)
+
+	if misc.IsStringSet(&FlagStripOutput) {
+		err = os.WriteFile(FlagStripOutput, []byte(text), 0666)
+	} else {
+		_, err = io.WriteString(os.Stdout, text)
+	}
+	if nil != err {
+		return fmt.Errorf("%w: %w", ErrOutput, err)
+	}
+	return nil
+}
+
+// stripColor returns the text of colorized output in format, one of
+// stripFormats. With auto, output that has escape sequences is taken
+// for ANSI and output that has tags for HTML; anything else is already
+// text.
+func stripColor(colored string, format string) string {
+	if stripAuto == format {
+		switch {
+		case strings.Contains(colored, "\x1b"):
+			format = formatANSI
+		case strings.Contains(colored, "<"):
+			format = formatHTML
+		default:
+			return colored
+		}
+	}
+	if formatANSI == format {
+		return stripANSI(colored)
+	}
+	return stripHTML(colored)
+}
+
+// stripANSI removes the escape sequences from ANSI output, and the
+// newline renderANSI writes after its final reset.
+func stripANSI(colored string) string {
+	return rxANSI.ReplaceAllString(strings.TrimSuffix(colored, ansiReset+"\n"), "")
+}
+
+// stripHTML returns the text of HTML output: span tags and the class
+// stylesheet are dropped and entities decoded. The newline renderRuns
+// writes after the stylesheet and after the outer span is not part of
+// the text, so a lone newline outside every span, just after </style>
+// or </span>, is dropped. Any other markup is reduced to its text as
+// htmlText does.
+func stripHTML(colored string) string {
+	var sb strings.Builder
+	var prev markupToken
+	depth := 0
+
+	for _, tok := range tokenizeMarkup(colored) {
+		switch {
+		case tok.kind == tokText:
+			text := colored[tok.start:tok.end]
+			if 0 == depth && "\n" == text && tokTag == prev.kind && prev.closing &&
+				("span" == prev.name || "style" == prev.name) {
+				break
+			}
+			sb.WriteString(html.UnescapeString(text))
+		case tok.kind == tokTag && "span" == tok.name:
+			if tok.closing {
+				depth = max(depth-1, 0)
+			} else {
+				depth++
+			}
+		case tok.kind == tokTag && (tok.name == "br" || (tok.closing && blockTags[tok.name])):
+			sb.WriteByte('\n')
+		}
+		prev = tok
+	}
+	return sb.String()
+}
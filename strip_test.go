@@ -0,0 +1,84 @@
+package main
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// stripText has what colorize must escape, entities that must not be
+// decoded twice, runs of whitespace, blank lines and non-ASCII text.
+const stripText = "Tom &amp; Jerry <b>\"bold\"</b> & 'x' > y\n" +
+	"\ttabs  and   spaces \n\n" +
+	"naïve café — 日本語 ✓ 🎨\n"
+
+// TestStripRoundTrip colorizes stripText in each output format colorize
+// writes, strips the result, and expects stripText back, byte for byte.
+func TestStripRoundTrip(t *testing.T) {
+	cases := []struct {
+		name string
+		args []string
+		// cssFile is set when the stylesheet goes to --css-file
+		cssFile bool
+	}{
+		{"inline", []string{"--css", "inline"}, false},
+		{"inline words", []string{"--css", "inline", "--unit", "word"}, false},
+		{"inline anti", []string{"--css", "inline", "--anti"}, false},
+		{"classes", []string{"--css", "classes"}, false},
+		{"classes css-file", []string{"--css", "classes"}, true},
+		{"optimize", []string{"--optimize"}, false},
+		{"optimize classes", []string{"--optimize", "--css", "classes"}, false},
+		{"annotate", []string{"--annotate"}, false},
+		{"annotate invent", []string{"--annotate", "--invent"}, false},
+		{"ansi", []string{"--format", "ansi"}, false},
+		{"ansi optimize", []string{"--format", "ansi", "--optimize"}, false},
+	}
+
+	for _, c := range cases {
+		for _, format := range []string{stripAuto, ""} {
+			name := c.name
+			if "" != format {
+				name += " auto"
+			}
+			t.Run(name, func(t *testing.T) {
+				dir := isolate(t)
+				args := append([]string{"colorize", "--text", stripText, "--buff=false",
+					"--nopaste=false", "--clipboard-backend", "file",
+					"--clipboard-file", filepath.Join(dir, "clipboard"),
+					"--output-dir", dir, "--output", "colored"}, c.args...)
+				if c.cssFile {
+					args = append(args, "--css-file", filepath.Join(dir, "colored.css"))
+				}
+				err := run(t, args...)
+				if nil != err {
+					t.Fatalf("colorize: %v", err)
+				}
+				colored := readFile(t, filepath.Join(dir, "colored"))
+				if c.cssFile && !strings.Contains(readFile(t, filepath.Join(dir, "colored.css")), "{") {
+					t.Fatalf("--css-file holds no rules")
+				}
+				wantStyle := strings.Contains(strings.Join(c.args, " "), cssClasses) && !c.cssFile
+				if wantStyle != strings.Contains(colored, "<style>") {
+					t.Fatalf("the <style> block is where it should not be:\n%s", colored)
+				}
+
+				stripFormat := format
+				if "" == stripFormat {
+					stripFormat = formatHTML
+					if strings.Contains(strings.Join(c.args, " "), formatANSI) {
+						stripFormat = formatANSI
+					}
+				}
+				stripped := filepath.Join(dir, "stripped")
+				err = run(t, "strip", "--format", stripFormat, "--output", stripped,
+					filepath.Join(dir, "colored"))
+				if nil != err {
+					t.Fatalf("strip: %v", err)
+				}
+				if got := readFile(t, stripped); stripText != got {
+					t.Errorf("strip gave\n%q\nwant\n%q\nfrom\n%s", got, stripText, colored)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"golang.design/x/clipboard"
+	"madcolor/misc"
+)
+
+// clipboardWatcher is implemented by backends that can report clipboard
+// changes themselves; the others are polled (see watchClipboard).
+type clipboardWatcher interface {
+	Watch(ctx context.Context) <-chan []byte
+}
+
+// Watch reports each new text/plain value of the clipboard.
+func (libClipboard) Watch(ctx context.Context) <-chan []byte {
+	return clipboard.Watch(ctx, clipboard.FmtText)
+}
+
+// watchClipboard returns a channel that receives the clipboard text each
+// time it changes, until ctx is done; what the clipboard holds when it
+// starts is not a change. Backends that cannot report changes are read
+// every interval. Read errors (an empty clipboard, or one that only
+// holds other flavors) are treated as "no text".
+func watchClipboard(ctx context.Context, backend clipboardBackend,
+	interval time.Duration) <-chan []byte {
+	if watcher, ok := backend.(clipboardWatcher); ok {
+		return watcher.Watch(ctx)
+	}
+
+	changes := make(chan []byte)
+	go func() {
+		defer close(changes)
+		last, _ := backend.Read(clipText)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			data, err := backend.Read(clipText)
+			if nil != err || bytes.Equal(data, last) {
+				continue
+			}
+			last = data
+			select {
+			case changes <- data:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return changes
+}
+
+// runWatch implements --watch-clipboard. It keeps running, colorizing
+// each new text entry on the clipboard in place, until SIGINT or SIGTERM.
+// Its own writes come back as clipboard changes and are skipped (they are
+// recognized by what reading the clipboard gives back after each, which
+// need not be byte for byte what was written), as is any text without
+// the --watch-trigger prefix (if one is set); the prefix itself is not
+// colorized. A clipboard entry that cannot be
+// colorized is logged and skipped; only a missing clipboard is an error.
+func runWatch() error {
+	var written [sha256.Size]byte
+
+	if !modeClipboardAvailable {
+		return usageErrorf("--watch-clipboard needs a clipboard, and %q is not available", FlagClipboardBackend)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	xLog.Info("watching the clipboard; interrupt to stop",
+		"clipboard", clipBackend.Name(), "trigger", FlagWatchTrigger)
+
+	for text := range watchClipboard(ctx, clipBackend, FlagWatchInterval) {
+		if sha256.Sum256(text) == written {
+			continue // our own paste
+		}
+		if misc.IsStringSet(&FlagWatchTrigger) {
+			if !bytes.HasPrefix(text, []byte(FlagWatchTrigger)) {
+				continue
+			}
+			text = text[len(FlagWatchTrigger):]
+		}
+
+		var out bytes.Buffer
+		bw := bufio.NewWriter(&out)
+		err := colorize(bufio.NewReader(bytes.NewReader(text)), bw)
+		if nil != err {
+			xLog.Error("Could not colorize the clipboard text", "err", err)
+			continue
+		}
+		misc.DeferError(bw.Flush)
+
+		var html []byte
+		if FlagPasteHTML && formatHTML == FlagFormat {
+			html = out.Bytes()
+		}
+		err = clipBackend.Write(out.Bytes(), html)
+		if nil != err {
+			xLog.Error("Could not write to the clipboard",
+				"clipboard", clipBackend.Name(), "err", err)
+			continue
+		}
+		back, err := clipBackend.Read(clipText)
+		if nil != err {
+			back = out.Bytes()
+		}
+		written = sha256.Sum256(back)
+		xLog.Info("colorized clipboard text", "bytes", len(text))
+	}
+
+	xLog.Info("stopped watching the clipboard", "clipboard", clipBackend.Name())
+	return nil
+}
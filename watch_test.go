@@ -0,0 +1,42 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// TestWatchClipboard checks that a polled clipboard reports changes,
+// but not what it held when watching started.
+func TestWatchClipboard(t *testing.T) {
+	clip := fileClipboard{path: filepath.Join(t.TempDir(), "clipboard")}
+	err := clip.Write([]byte("already there"), nil)
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	changes := watchClipboard(ctx, clip, time.Millisecond)
+
+	select {
+	case text := <-changes:
+		t.Fatalf("reported %q, which was there at the start", text)
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	err = os.WriteFile(clip.path, []byte("new"), 0666)
+	if nil != err {
+		t.Fatal(err)
+	}
+	select {
+	case text := <-changes:
+		if "new" != string(text) {
+			t.Errorf("reported %q, want %q", text, "new")
+		}
+	case <-time.After(time.Second):
+		t.Errorf("a change was not reported")
+	}
+}
@@ -0,0 +1,482 @@
+package main
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// x11Conn is a minimal client of the X11 core protocol: just enough to
+// own and read selections (see x11clipboard.go), without cgo or Xlib.
+// It is not safe for concurrent use. Events that arrive while waiting
+// for a reply are queued for nextEvent.
+type x11Conn struct {
+	conn    net.Conn
+	r       *bufio.Reader
+	seq     uint16        // sequence number of the last request sent
+	timeout time.Duration // for each reply or event; 0 waits forever
+
+	root       uint32 // root window of the first screen
+	idBase     uint32
+	idMask     uint32
+	lastID     uint32
+	maxRequest int // bytes
+
+	events [][]byte
+	atoms  map[string]uint32
+}
+
+// X11 request opcodes
+const (
+	x11CreateWindow            = 1
+	x11ChangeWindowAttributes  = 2
+	x11InternAtom              = 16
+	x11ChangeProperty          = 18
+	x11DeleteProperty          = 19
+	x11GetProperty             = 20
+	x11SetSelectionOwner       = 22
+	x11GetSelectionOwner       = 23
+	x11ConvertSelection        = 24
+	x11SendEvent               = 25
+	x11PropModeReplace         = 0
+	x11PropModeAppend          = 2
+	x11EventMaskPropertyChange = 0x00400000
+	x11CWEventMask             = 0x00000800
+	x11WindowClassInputOnly    = 2
+)
+
+// X11 event codes
+const (
+	x11PropertyNotify   = 28
+	x11SelectionClear   = 29
+	x11SelectionRequest = 30
+	x11SelectionNotify  = 31
+)
+
+// predefined X11 atoms
+const (
+	x11AtomNone    = 0
+	x11AtomAtom    = 4
+	x11AtomInteger = 19
+	x11AtomString  = 31
+)
+
+// x11PropertyDeleted is the state of a PropertyNotify for a deleted
+// property (a new value is 0).
+const x11PropertyDeleted = 1
+
+// x11DialTimeout bounds connecting to the display and its setup.
+const x11DialTimeout = 3 * time.Second
+
+// dialX11 connects to display (the $DISPLAY syntax: [host]:number[.screen],
+// or a socket path as XQuartz sets it), authenticating with the
+// MIT-MAGIC-COOKIE-1 from the Xauthority file when there is one.
+func dialX11(display string) (x *x11Conn, err error) {
+	conn, host, number, err := dialDisplay(display)
+	if nil != err {
+		return nil, err
+	}
+	x = &x11Conn{conn: conn, r: bufio.NewReader(conn), atoms: make(map[string]uint32)}
+	err = conn.SetDeadline(time.Now().Add(x11DialTimeout))
+	if nil == err {
+		err = x.setup(xauthCookie(host, number))
+	}
+	if nil == err {
+		err = conn.SetDeadline(time.Time{})
+	}
+	if nil != err {
+		_ = conn.Close()
+		return nil, fmt.Errorf("could not connect to the X display %q because %w", display, err)
+	}
+	return x, nil
+}
+
+// dialDisplay opens the connection to display, and returns the host and
+// display number it names (for xauthCookie).
+func dialDisplay(display string) (conn net.Conn, host string, number string, err error) {
+	ix := strings.LastIndexByte(display, ':')
+	if ix < 0 {
+		return nil, "", "", fmt.Errorf("bad X display %q", display)
+	}
+	host, number = display[:ix], display[ix+1:]
+	number, _, _ = strings.Cut(number, ".")
+	n, err := strconv.Atoi(number)
+	if nil != err || n < 0 {
+		return nil, "", "", fmt.Errorf("bad X display %q", display)
+	}
+
+	switch {
+	case strings.HasPrefix(host, "/"):
+		// XQuartz: the whole name, up to the screen, is the socket
+		conn, err = net.DialTimeout("unix", host+":"+number, x11DialTimeout)
+		host = ""
+	case "" == host || "unix" == host:
+		socket := "/tmp/.X11-unix/X" + number
+		conn, err = net.DialTimeout("unix", socket, x11DialTimeout)
+		if nil != err {
+			// Linux also listens in the abstract namespace
+			var abstractErr error
+			conn, abstractErr = net.DialTimeout("unix", "@"+socket, x11DialTimeout)
+			if nil == abstractErr {
+				err = nil
+			}
+		}
+		host = ""
+	default:
+		conn, err = net.DialTimeout("tcp", net.JoinHostPort(host, strconv.Itoa(6000+n)), x11DialTimeout)
+	}
+	return conn, host, number, err
+}
+
+// xauthCookie returns the MIT-MAGIC-COOKIE-1 for the display from
+// $XAUTHORITY or ~/.Xauthority, or nil if there is none. host is empty
+// for a local display.
+func xauthCookie(host string, number string) []byte {
+	path := os.Getenv("XAUTHORITY")
+	if "" == path {
+		home, err := os.UserHomeDir()
+		if nil != err {
+			return nil
+		}
+		path = filepath.Join(home, ".Xauthority")
+	}
+	data, err := os.ReadFile(path)
+	if nil != err {
+		return nil
+	}
+	local, _ := os.Hostname()
+	if "" == host {
+		host = local
+	}
+
+	const (
+		familyInternet = 0
+		familyLocal    = 256
+		familyWild     = 65535
+	)
+	// each entry is a family, then address, number, name and data, each
+	// a big-endian length and its bytes
+	for 2 <= len(data) {
+		family := binary.BigEndian.Uint16(data)
+		data = data[2:]
+		var fields [4][]byte
+		for ix := range fields {
+			if len(data) < 2 || len(data) < 2+int(binary.BigEndian.Uint16(data)) {
+				return nil
+			}
+			n := int(binary.BigEndian.Uint16(data))
+			fields[ix], data = data[2:2+n], data[2+n:]
+		}
+		address, entryNumber, name, cookie := fields[0], fields[1], fields[2], fields[3]
+		if "MIT-MAGIC-COOKIE-1" != string(name) || (0 != len(entryNumber) && number != string(entryNumber)) {
+			continue
+		}
+		switch family {
+		case familyWild:
+			return cookie
+		case familyLocal:
+			if host == string(address) {
+				return cookie
+			}
+		case familyInternet:
+			ip := net.ParseIP(host)
+			if nil != ip && ip.To4().Equal(net.IP(address)) {
+				return cookie
+			}
+		}
+	}
+	return nil
+}
+
+// setup exchanges the connection setup with the server.
+func (x *x11Conn) setup(cookie []byte) error {
+	var name []byte
+	if nil != cookie {
+		name = []byte("MIT-MAGIC-COOKIE-1")
+	}
+	req := []byte{'l', 0}
+	req = binary.LittleEndian.AppendUint16(req, 11)
+	req = binary.LittleEndian.AppendUint16(req, 0)
+	req = binary.LittleEndian.AppendUint16(req, uint16(len(name)))
+	req = binary.LittleEndian.AppendUint16(req, uint16(len(cookie)))
+	req = append(req, 0, 0)
+	req = append(req, x11Pad(name)...)
+	req = append(req, x11Pad(cookie)...)
+	_, err := x.conn.Write(req)
+	if nil != err {
+		return err
+	}
+
+	head := make([]byte, 8)
+	_, err = io.ReadFull(x.r, head)
+	if nil != err {
+		return err
+	}
+	info := make([]byte, 4*int(binary.LittleEndian.Uint16(head[6:])))
+	_, err = io.ReadFull(x.r, info)
+	if nil != err {
+		return err
+	}
+	switch head[0] {
+	case 0:
+		return fmt.Errorf("the X server refused the connection: %s",
+			strings.TrimSpace(string(info[:min(int(head[1]), len(info))])))
+	case 1:
+	default:
+		return errors.New("the X server asked for an authentication madcolor does not support")
+	}
+
+	if len(info) < 32 {
+		return errors.New("short X connection setup")
+	}
+	x.idBase = binary.LittleEndian.Uint32(info[4:])
+	x.idMask = binary.LittleEndian.Uint32(info[8:])
+	vendor := int(binary.LittleEndian.Uint16(info[16:]))
+	x.maxRequest = 4 * int(binary.LittleEndian.Uint16(info[18:]))
+	formats := int(info[21])
+	screen := 32 + (vendor+3)&^3 + 8*formats
+	if len(info) < screen+4 || 0 == info[20] {
+		return errors.New("the X server has no screen")
+	}
+	x.root = binary.LittleEndian.Uint32(info[screen:])
+	return nil
+}
+
+// Close closes the connection; the server then drops the windows and
+// selections the client had.
+func (x *x11Conn) Close() error {
+	return x.conn.Close()
+}
+
+// x11Pad returns b padded with zeros to a multiple of 4 bytes.
+func x11Pad(b []byte) []byte {
+	return append(b[:len(b):len(b)], make([]byte, (4-len(b)%4)%4)...)
+}
+
+// send sends a request, whose body is padded and given its length, and
+// returns its sequence number.
+func (x *x11Conn) send(opcode byte, data byte, body []byte) (seq uint16, err error) {
+	body = x11Pad(body)
+	req := []byte{opcode, data}
+	req = binary.LittleEndian.AppendUint16(req, uint16(1+len(body)/4))
+	_, err = x.conn.Write(append(req, body...))
+	x.seq++
+	return x.seq, err
+}
+
+// x11Body returns the CARD32 values as a request body.
+func x11Body(values ...uint32) []byte {
+	var body []byte
+	for _, v := range values {
+		body = binary.LittleEndian.AppendUint32(body, v)
+	}
+	return body
+}
+
+// x11Error is an error the X server returned for a request.
+type x11Error struct {
+	code byte
+	seq  uint16
+}
+
+func (e x11Error) Error() string {
+	return fmt.Sprintf("X error %d for request %d", e.code, e.seq)
+}
+
+// readPacket reads a reply, an error or an event.
+func (x *x11Conn) readPacket() ([]byte, error) {
+	if 0 < x.timeout {
+		_ = x.conn.SetReadDeadline(time.Now().Add(x.timeout))
+	}
+	packet := make([]byte, 32)
+	_, err := io.ReadFull(x.r, packet)
+	if nil != err {
+		return nil, err
+	}
+	if 1 == packet[0] {
+		extra := make([]byte, 4*int(binary.LittleEndian.Uint32(packet[4:])))
+		_, err = io.ReadFull(x.r, extra)
+		packet = append(packet, extra...)
+	}
+	return packet, err
+}
+
+// reply returns the reply to request seq, or its error.
+func (x *x11Conn) reply(seq uint16) ([]byte, error) {
+	for {
+		packet, err := x.readPacket()
+		if nil != err {
+			return nil, err
+		}
+		packetSeq := binary.LittleEndian.Uint16(packet[2:])
+		switch {
+		case 1 < packet[0]:
+			x.events = append(x.events, packet)
+		case packetSeq != seq:
+			// the reply or error of an earlier request, which nobody
+			// waits for any more
+		case 0 == packet[0]:
+			return nil, x11Error{code: packet[1], seq: seq}
+		default:
+			return packet, nil
+		}
+	}
+}
+
+// nextEvent returns the next event, with the bit that marks events sent
+// by SendEvent cleared from its code. Errors for requests that have no
+// reply are skipped: for a selection owner they mean a requestor went
+// away, which is its business.
+func (x *x11Conn) nextEvent() ([]byte, error) {
+	for {
+		if 0 < len(x.events) {
+			event := x.events[0]
+			x.events = x.events[1:]
+			event[0] &= 0x7f
+			return event, nil
+		}
+		packet, err := x.readPacket()
+		if nil != err {
+			return nil, err
+		}
+		if 1 < packet[0] {
+			x.events = append(x.events, packet)
+		}
+	}
+}
+
+// newID returns a new resource id.
+func (x *x11Conn) newID() uint32 {
+	x.lastID++
+	return x.idBase | (x.lastID & x.idMask)
+}
+
+// atom returns the atom for name, interning it on the server.
+func (x *x11Conn) atom(name string) (uint32, error) {
+	if a, ok := x.atoms[name]; ok {
+		return a, nil
+	}
+	body := binary.LittleEndian.AppendUint16(nil, uint16(len(name)))
+	body = append(body, 0, 0)
+	seq, err := x.send(x11InternAtom, 0, append(body, name...))
+	if nil != err {
+		return 0, err
+	}
+	reply, err := x.reply(seq)
+	if nil != err {
+		return 0, err
+	}
+	a := binary.LittleEndian.Uint32(reply[8:])
+	x.atoms[name] = a
+	return a, nil
+}
+
+// createWindow creates an unmapped InputOnly window, on which the
+// client gets PropertyNotify events: selections are owned and converted
+// through a window.
+func (x *x11Conn) createWindow() (uint32, error) {
+	id := x.newID()
+	body := x11Body(id, x.root)
+	body = binary.LittleEndian.AppendUint16(body, 0) // x
+	body = binary.LittleEndian.AppendUint16(body, 0) // y
+	body = binary.LittleEndian.AppendUint16(body, 1) // width
+	body = binary.LittleEndian.AppendUint16(body, 1) // height
+	body = binary.LittleEndian.AppendUint16(body, 0) // border width
+	body = binary.LittleEndian.AppendUint16(body, x11WindowClassInputOnly)
+	body = append(body, x11Body(0, x11CWEventMask, x11EventMaskPropertyChange)...)
+	_, err := x.send(x11CreateWindow, 0, body)
+	return id, err
+}
+
+// selectPropertyEvents sets whether the client gets PropertyNotify
+// events for a window it did not create.
+func (x *x11Conn) selectPropertyEvents(window uint32, on bool) error {
+	var mask uint32
+	if on {
+		mask = x11EventMaskPropertyChange
+	}
+	_, err := x.send(x11ChangeWindowAttributes, 0, x11Body(window, x11CWEventMask, mask))
+	return err
+}
+
+// changeProperty sets (or with x11PropModeAppend, extends) a property
+// to data, in units of format bits.
+func (x *x11Conn) changeProperty(mode byte, window, property, typ uint32, format byte, data []byte) error {
+	body := x11Body(window, property, typ)
+	body = append(body, format, 0, 0, 0)
+	body = binary.LittleEndian.AppendUint32(body, uint32(len(data)*8/int(format)))
+	_, err := x.send(x11ChangeProperty, mode, append(body, data...))
+	return err
+}
+
+// deleteProperty deletes a property of a window.
+func (x *x11Conn) deleteProperty(window, property uint32) error {
+	_, err := x.send(x11DeleteProperty, 0, x11Body(window, property))
+	return err
+}
+
+// getProperty returns the type and the whole value of a property, in as
+// many requests as the server needs.
+func (x *x11Conn) getProperty(window, property uint32) (typ uint32, value []byte, err error) {
+	for {
+		seq, err := x.send(x11GetProperty, 0,
+			x11Body(window, property, x11AtomNone, uint32(len(value)/4), uint32(x.maxRequest/4)))
+		if nil != err {
+			return 0, nil, err
+		}
+		reply, err := x.reply(seq)
+		if nil != err {
+			return 0, nil, err
+		}
+		typ = binary.LittleEndian.Uint32(reply[8:])
+		after := binary.LittleEndian.Uint32(reply[12:])
+		n := int(binary.LittleEndian.Uint32(reply[16:])) * int(reply[1]) / 8
+		if len(reply) < 32+n {
+			return 0, nil, errors.New("short X property reply")
+		}
+		value = append(value, reply[32:32+n]...)
+		if 0 == after || 0 == n {
+			return typ, value, nil
+		}
+	}
+}
+
+// setSelectionOwner makes window the owner of selection as of time.
+func (x *x11Conn) setSelectionOwner(window, selection, time uint32) error {
+	_, err := x.send(x11SetSelectionOwner, 0, x11Body(window, selection, time))
+	return err
+}
+
+// selectionOwner returns the window that owns selection, or 0.
+func (x *x11Conn) selectionOwner(selection uint32) (uint32, error) {
+	seq, err := x.send(x11GetSelectionOwner, 0, x11Body(selection))
+	if nil != err {
+		return 0, err
+	}
+	reply, err := x.reply(seq)
+	if nil != err {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint32(reply[8:]), nil
+}
+
+// convertSelection asks the owner of selection to store it as target in
+// property of window.
+func (x *x11Conn) convertSelection(window, selection, target, property uint32) error {
+	_, err := x.send(x11ConvertSelection, 0, x11Body(window, selection, target, property, 0))
+	return err
+}
+
+// sendEvent sends a 32-byte event to the client that created window.
+func (x *x11Conn) sendEvent(window uint32, event []byte) error {
+	_, err := x.send(x11SendEvent, 0, append(x11Body(window, 0), event...))
+	return err
+}
@@ -0,0 +1,485 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"os/exec"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+	"unicode/utf8"
+
+	"madcolor/misc"
+)
+
+// selectionCommand is the hidden command that the x11 backend runs in
+// the background to own the clipboard: an X11 selection is not stored by
+// the server but served by its owner, for as long as the owner runs.
+const selectionCommand = "__x11-selection"
+
+// x11Timeout bounds each wait for the X server or a selection owner when
+// reading the clipboard.
+const x11Timeout = 3 * time.Second
+
+// x11Clipboard reads and owns the X11 CLIPBOARD selection itself. The
+// owner offers the text and the HTML flavor at once, under the targets
+// applications ask for (UTF8_STRING, text/plain, text/html...), so that
+// rich text editors paste the HTML and plain text ones the text. Writing
+// starts a background madcolor (selectionCommand) that owns the
+// selection until another program takes it, as xclip does. Under
+// Wayland, this is the Xwayland clipboard, which the compositor shares
+// with Wayland applications.
+type x11Clipboard struct {
+	display string
+}
+
+func (x11Clipboard) Name() string { return "x11" }
+
+// HoldsHTML reports that the x11 clipboard keeps both flavors.
+func (x11Clipboard) HoldsHTML() bool { return true }
+
+// available reports whether the display accepts a connection.
+func (c x11Clipboard) available() error {
+	x, err := dialX11(c.display)
+	if nil != err {
+		return err
+	}
+	return x.Close()
+}
+
+// x11TextTargets are the targets asked for the text flavor, in order of
+// preference; STRING is Latin-1.
+var x11TextTargets = []string{"UTF8_STRING", "text/plain;charset=utf-8", "text/plain", "STRING"}
+
+func (c x11Clipboard) Read(format clipFormat) ([]byte, error) {
+	x, err := dialX11(c.display)
+	if nil != err {
+		return nil, err
+	}
+	defer func() { _ = x.Close() }()
+	x.timeout = x11Timeout
+
+	targets := x11TextTargets
+	if clipHTML == format {
+		targets = []string{clipHTML.String()}
+	}
+	return readSelection(x, targets)
+}
+
+// readSelection returns the CLIPBOARD converted to the first of targets
+// its owner supports; STRING is converted from Latin-1. It returns
+// errClipFormat if there is no owner or it supports none of them.
+func readSelection(x *x11Conn, targets []string) ([]byte, error) {
+	clipboardAtom, err := x.atom("CLIPBOARD")
+	if nil != err {
+		return nil, err
+	}
+	owner, err := x.selectionOwner(clipboardAtom)
+	if nil != err {
+		return nil, err
+	}
+	if 0 == owner {
+		return nil, fmt.Errorf("the clipboard is empty: %w", errClipFormat)
+	}
+	property, err := x.atom("MADCOLOR_SELECTION")
+	if nil != err {
+		return nil, err
+	}
+	incr, err := x.atom("INCR")
+	if nil != err {
+		return nil, err
+	}
+	window, err := x.createWindow()
+	if nil != err {
+		return nil, err
+	}
+
+	for _, name := range targets {
+		target, err := x.atom(name)
+		if nil != err {
+			return nil, err
+		}
+		err = x.convertSelection(window, clipboardAtom, target, property)
+		if nil != err {
+			return nil, err
+		}
+		var event []byte
+		for nil == event || x11SelectionNotify != event[0] || window != binary.LittleEndian.Uint32(event[8:]) {
+			event, err = x.nextEvent()
+			if nil != err {
+				return nil, fmt.Errorf("the clipboard owner did not answer: %w", err)
+			}
+		}
+		if x11AtomNone == binary.LittleEndian.Uint32(event[20:]) {
+			continue
+		}
+
+		typ, data, err := x.getProperty(window, property)
+		if nil == err {
+			err = x.deleteProperty(window, property)
+		}
+		if nil == err && incr == typ {
+			data, err = readIncremental(x, window, property)
+		}
+		if nil != err {
+			return nil, err
+		}
+		if "STRING" == name {
+			data = latin1ToUTF8(data)
+		}
+		return data, nil
+	}
+	return nil, fmt.Errorf("the clipboard has no %s: %w", strings.Join(targets, " or "), errClipFormat)
+}
+
+// readIncremental reads a value the owner sends in chunks (the INCR
+// protocol): each chunk is a new value of the property, which is deleted
+// to ask for the next, until an empty one.
+func readIncremental(x *x11Conn, window, property uint32) (data []byte, err error) {
+	for {
+		event, err := x.nextEvent()
+		if nil != err {
+			return nil, fmt.Errorf("the clipboard owner stopped sending: %w", err)
+		}
+		if x11PropertyNotify != event[0] || window != binary.LittleEndian.Uint32(event[4:]) ||
+			property != binary.LittleEndian.Uint32(event[8:]) || x11PropertyDeleted == event[16] {
+			continue
+		}
+		_, chunk, err := x.getProperty(window, property)
+		if nil == err {
+			err = x.deleteProperty(window, property)
+		}
+		if nil != err {
+			return nil, err
+		}
+		if 0 == len(chunk) {
+			return data, nil
+		}
+		data = append(data, chunk...)
+	}
+}
+
+// latin1ToUTF8 converts Latin-1 text (the STRING target) to UTF-8.
+func latin1ToUTF8(b []byte) []byte {
+	out := make([]byte, 0, len(b))
+	for _, c := range b {
+		out = utf8.AppendRune(out, rune(c))
+	}
+	return out
+}
+
+// utf8ToLatin1 converts UTF-8 text to Latin-1 for the STRING target,
+// with "?" for the characters Latin-1 does not have.
+func utf8ToLatin1(b []byte) []byte {
+	out := make([]byte, 0, len(b))
+	for _, r := range string(b) {
+		if r > 0xff {
+			r = '?'
+		}
+		out = append(out, byte(r))
+	}
+	return out
+}
+
+// Write starts a madcolor in the background that owns the clipboard with
+// text and html, and returns once it does. The text and the HTML are
+// passed on its stdin: the length of the text as 8 bytes, the text, and
+// the HTML.
+func (c x11Clipboard) Write(text []byte, html []byte) error {
+	exe, err := os.Executable()
+	if nil != err {
+		return err
+	}
+	cmd := exec.Command(exe, selectionCommand)
+	cmd.Env = append(os.Environ(), "DISPLAY="+c.display)
+	input := binary.BigEndian.AppendUint64(nil, uint64(len(text)))
+	input = append(append(input, text...), html...)
+	cmd.Stdin = bytes.NewReader(input)
+	// not cmd.StdoutPipe, which Wait closes: the owner may exit before
+	// its status is read
+	stdout, w, err := os.Pipe()
+	if nil != err {
+		return err
+	}
+	defer misc.DeferError(stdout.Close)
+	cmd.Stdout = w
+	err = cmd.Start()
+	_ = w.Close()
+	if nil != err {
+		return fmt.Errorf("could not start the clipboard owner because %w", err)
+	}
+	// reap it when it exits, should madcolor still be running
+	go func() { _ = cmd.Wait() }()
+
+	status, err := bufio.NewReader(stdout).ReadString('\n')
+	if nil != err {
+		return fmt.Errorf("the clipboard owner exited because %w", err)
+	}
+	if status = strings.TrimSpace(status); "ok" != status {
+		return errors.New(status)
+	}
+	return nil
+}
+
+// runSelectionOwner runs selectionCommand: it reads the text and the
+// HTML from stdin (see x11Clipboard.Write), takes the clipboard, writes
+// "ok" (or why it could not) to stdout and closes it, and then serves the
+// clipboard until another program takes it. It outlives the madcolor
+// that started it, and the terminal that one ran in.
+func runSelectionOwner(_ []string) error {
+	xLog = slog.New(slog.NewTextHandler(io.Discard, nil))
+	signal.Ignore(syscall.SIGHUP, syscall.SIGINT)
+
+	ready := func(err error) {
+		status := "ok"
+		if nil != err {
+			status = err.Error()
+		}
+		_, _ = fmt.Fprintln(os.Stdout, status)
+		_ = os.Stdout.Close()
+	}
+
+	input, err := io.ReadAll(os.Stdin)
+	if nil == err && len(input) < 8 {
+		err = errors.New("no clipboard contents given")
+	}
+	if nil != err {
+		ready(err)
+		return err
+	}
+	n := binary.BigEndian.Uint64(input)
+	if n > uint64(len(input)-8) {
+		err = errors.New("truncated clipboard contents")
+		ready(err)
+		return err
+	}
+	text, html := input[8:8+n], input[8+n:]
+	if 0 == len(html) {
+		html = nil
+	}
+
+	x, err := dialX11(os.Getenv("DISPLAY"))
+	if nil != err {
+		ready(err)
+		return err
+	}
+	defer func() { _ = x.Close() }()
+	// the working directory is not needed, and should not be kept busy
+	_ = os.Chdir(string(os.PathSeparator))
+	return serveSelection(x, text, html, ready)
+}
+
+// x11Selection is the CLIPBOARD as madcolor owns it.
+type x11Selection struct {
+	x      *x11Conn
+	window uint32
+	time   uint32 // when it was taken
+	text   []byte
+	html   []byte // nil if there is no HTML flavor
+	atoms  map[string]uint32
+
+	// transfers are the INCR transfers under way, by requestor window
+	// and property
+	transfers map[[2]uint32]*x11Transfer
+}
+
+// x11Transfer is an INCR transfer: the data left to send, in chunks, and
+// its type.
+type x11Transfer struct {
+	typ  uint32
+	data []byte
+	done bool // the empty chunk that ends it was sent
+}
+
+// x11SelectionAtoms are the atoms the owner needs; the targets among them
+// are offered by targets.
+var x11SelectionAtoms = []string{"CLIPBOARD", "TARGETS", "TIMESTAMP", "INCR", "TEXT",
+	"UTF8_STRING", "text/plain;charset=utf-8", "text/plain", "text/html", "MADCOLOR_SELECTION"}
+
+// serveSelection takes the CLIPBOARD with text and html, calls ready once
+// it owns it (or with the error if it cannot), and answers the requests
+// for it until another client takes it.
+func serveSelection(x *x11Conn, text []byte, html []byte, ready func(error)) error {
+	s := &x11Selection{x: x, text: text, html: html, atoms: make(map[string]uint32),
+		transfers: make(map[[2]uint32]*x11Transfer)}
+	err := s.take()
+	ready(err)
+	if nil != err {
+		return err
+	}
+
+	for {
+		event, err := x.nextEvent()
+		if nil != err {
+			return err
+		}
+		switch event[0] {
+		case x11SelectionClear:
+			if s.atoms["CLIPBOARD"] == binary.LittleEndian.Uint32(event[12:]) {
+				return nil
+			}
+		case x11SelectionRequest:
+			err = s.answer(event)
+		case x11PropertyNotify:
+			if x11PropertyDeleted == event[16] {
+				err = s.sendChunk(binary.LittleEndian.Uint32(event[4:]), binary.LittleEndian.Uint32(event[8:]))
+			}
+		}
+		if nil != err {
+			return err
+		}
+	}
+}
+
+// take interns the atoms, and makes a new window the owner of the
+// CLIPBOARD. The time of a PropertyNotify on the window stands for
+// "now": ICCCM asks owners not to use CurrentTime.
+func (s *x11Selection) take() (err error) {
+	s.x.timeout = x11Timeout
+	defer func() { s.x.timeout = 0 }()
+	for _, name := range x11SelectionAtoms {
+		s.atoms[name], err = s.x.atom(name)
+		if nil != err {
+			return err
+		}
+	}
+	s.window, err = s.x.createWindow()
+	if nil != err {
+		return err
+	}
+	err = s.x.changeProperty(x11PropModeAppend, s.window, s.atoms["MADCOLOR_SELECTION"], x11AtomString, 8, nil)
+	if nil != err {
+		return err
+	}
+	for {
+		event, err := s.x.nextEvent()
+		if nil != err {
+			return err
+		}
+		if x11PropertyNotify == event[0] && s.window == binary.LittleEndian.Uint32(event[4:]) {
+			s.time = binary.LittleEndian.Uint32(event[12:])
+			break
+		}
+	}
+
+	err = s.x.setSelectionOwner(s.window, s.atoms["CLIPBOARD"], s.time)
+	if nil != err {
+		return err
+	}
+	owner, err := s.x.selectionOwner(s.atoms["CLIPBOARD"])
+	if nil == err && s.window != owner {
+		err = errors.New("could not take the X11 clipboard")
+	}
+	return err
+}
+
+// targets returns the targets offered, the HTML one only if there is
+// HTML.
+func (s *x11Selection) targets() []string {
+	targets := []string{"TARGETS", "TIMESTAMP", "UTF8_STRING", "text/plain;charset=utf-8",
+		"text/plain", "TEXT", "STRING"}
+	if nil != s.html {
+		targets = append(targets, "text/html")
+	}
+	return targets
+}
+
+// convert returns the selection as target: the type and format of the
+// value, and the value. ok is false for a target that is not offered.
+func (s *x11Selection) convert(target uint32) (typ uint32, format byte, value []byte, ok bool) {
+	switch target {
+	case s.atoms["TARGETS"]:
+		for _, name := range s.targets() {
+			a := uint32(x11AtomString)
+			if "STRING" != name {
+				a = s.atoms[name]
+			}
+			value = binary.LittleEndian.AppendUint32(value, a)
+		}
+		return x11AtomAtom, 32, value, true
+	case s.atoms["TIMESTAMP"]:
+		return x11AtomInteger, 32, binary.LittleEndian.AppendUint32(nil, s.time), true
+	case s.atoms["UTF8_STRING"], s.atoms["text/plain;charset=utf-8"], s.atoms["text/plain"]:
+		return target, 8, s.text, true
+	case s.atoms["TEXT"]:
+		return s.atoms["UTF8_STRING"], 8, s.text, true
+	case x11AtomString:
+		return x11AtomString, 8, utf8ToLatin1(s.text), true
+	case s.atoms["text/html"]:
+		if nil != s.html {
+			return target, 8, s.html, true
+		}
+	}
+	return 0, 0, nil, false
+}
+
+// answer answers a SelectionRequest: it stores the selection, converted
+// to the target asked for, in the property of the requestor, and
+// notifies it, with property None if the target is not offered. Values
+// too large for one request are sent with INCR.
+func (s *x11Selection) answer(event []byte) error {
+	time := binary.LittleEndian.Uint32(event[4:])
+	requestor := binary.LittleEndian.Uint32(event[12:])
+	selection := binary.LittleEndian.Uint32(event[16:])
+	target := binary.LittleEndian.Uint32(event[20:])
+	property := binary.LittleEndian.Uint32(event[24:])
+	if x11AtomNone == property {
+		// an obsolete client
+		property = target
+	}
+
+	typ, format, value, ok := s.convert(target)
+	if !ok || s.atoms["CLIPBOARD"] != selection || (0 != time && time < s.time) {
+		property = x11AtomNone
+	} else {
+		var err error
+		if chunk := s.chunkSize(); len(value) > chunk {
+			err = s.x.selectPropertyEvents(requestor, true)
+			if nil == err {
+				s.transfers[[2]uint32{requestor, property}] = &x11Transfer{typ: typ, data: value}
+				size := binary.LittleEndian.AppendUint32(nil, uint32(len(value)))
+				err = s.x.changeProperty(x11PropModeReplace, requestor, property, s.atoms["INCR"], 32, size)
+			}
+		} else {
+			err = s.x.changeProperty(x11PropModeReplace, requestor, property, typ, format, value)
+		}
+		if nil != err {
+			return err
+		}
+	}
+
+	notify := []byte{x11SelectionNotify, 0, 0, 0}
+	notify = append(notify, x11Body(time, requestor, selection, target, property)...)
+	return s.x.sendEvent(requestor, append(notify, make([]byte, 32-len(notify))...))
+}
+
+// chunkSize is the largest value sent in one request.
+func (s *x11Selection) chunkSize() int {
+	return min(s.x.maxRequest-64, 1<<18)
+}
+
+// sendChunk sends the next chunk of the INCR transfer to requestor, if
+// it deleted the property that holds the last one. An empty chunk ends
+// the transfer.
+func (s *x11Selection) sendChunk(requestor, property uint32) error {
+	key := [2]uint32{requestor, property}
+	transfer, ok := s.transfers[key]
+	if !ok {
+		return nil
+	}
+	if transfer.done {
+		delete(s.transfers, key)
+		return s.x.selectPropertyEvents(requestor, false)
+	}
+	chunk := transfer.data[:min(len(transfer.data), s.chunkSize())]
+	transfer.data = transfer.data[len(chunk):]
+	transfer.done = 0 == len(chunk)
+	return s.x.changeProperty(x11PropModeReplace, requestor, property, transfer.typ, 8, chunk)
+}
@@ -0,0 +1,412 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeX is an X server that implements the requests and events the x11
+// clipboard uses, following the protocol spec, for tests to run without
+// a display.
+type fakeX struct {
+	display    string
+	cookie     []byte // the MIT-MAGIC-COOKIE-1 clients must give
+	maxRequest uint16 // in 4-byte units
+
+	mu      sync.Mutex
+	clients int
+	time    uint32
+	atoms   map[string]uint32
+	windows map[uint32]*fakeWindow
+	owners  map[uint32]uint32 // selection: window
+}
+
+// fakeClient is a connection to fakeX.
+type fakeClient struct {
+	conn net.Conn
+	seq  uint16
+}
+
+// fakeWindow is a window and its properties.
+type fakeWindow struct {
+	creator *fakeClient
+	masks   map[*fakeClient]uint32
+	props   map[uint32]fakeProperty
+}
+
+// fakeProperty is the value of a property.
+type fakeProperty struct {
+	typ    uint32
+	format byte
+	data   []byte
+}
+
+// startFakeX starts a fakeX on a socket named as XQuartz names them, so
+// that DISPLAY can point to it, and sets DISPLAY and XAUTHORITY for the
+// test.
+func startFakeX(t *testing.T, maxRequest uint16) *fakeX {
+	t.Helper()
+	dir := t.TempDir()
+	x := &fakeX{display: filepath.Join(dir, "fake:0"), cookie: []byte("0123456789abcdef"),
+		maxRequest: maxRequest, atoms: map[string]uint32{"ATOM": x11AtomAtom,
+			"INTEGER": x11AtomInteger, "STRING": x11AtomString},
+		windows: map[uint32]*fakeWindow{1: {masks: map[*fakeClient]uint32{}, props: map[uint32]fakeProperty{}}},
+		owners:  map[uint32]uint32{}}
+	ln, err := net.Listen("unix", x.display)
+	if nil != err {
+		t.Fatal(err)
+	}
+	var conns []net.Conn
+	var connsMu sync.Mutex
+	t.Cleanup(func() {
+		_ = ln.Close()
+		connsMu.Lock()
+		defer connsMu.Unlock()
+		for _, c := range conns {
+			_ = c.Close()
+		}
+	})
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if nil != err {
+				return
+			}
+			connsMu.Lock()
+			conns = append(conns, conn)
+			connsMu.Unlock()
+			go x.serve(&fakeClient{conn: conn})
+		}
+	}()
+
+	// an entry for another display, then the one for this display
+	var auth []byte
+	for _, entry := range [][4]string{{"vm", "1", "MIT-MAGIC-COOKIE-1", "wrong"},
+		{"", "0", "MIT-MAGIC-COOKIE-1", string(x.cookie)}} {
+		auth = binary.BigEndian.AppendUint16(auth, 65535)
+		for _, field := range entry {
+			auth = binary.BigEndian.AppendUint16(auth, uint16(len(field)))
+			auth = append(auth, field...)
+		}
+	}
+	authPath := filepath.Join(dir, "Xauthority")
+	err = os.WriteFile(authPath, auth, 0600)
+	if nil != err {
+		t.Fatal(err)
+	}
+	t.Setenv("XAUTHORITY", authPath)
+	t.Setenv("DISPLAY", x.display)
+	return x
+}
+
+// serve answers the requests of one client.
+func (x *fakeX) serve(c *fakeClient) {
+	defer func() { _ = c.conn.Close() }()
+	head := make([]byte, 12)
+	if _, err := io.ReadFull(c.conn, head); nil != err || 'l' != head[0] {
+		return
+	}
+	n, d := int(binary.LittleEndian.Uint16(head[6:])), int(binary.LittleEndian.Uint16(head[8:]))
+	auth := make([]byte, (n+3)&^3+(d+3)&^3)
+	if _, err := io.ReadFull(c.conn, auth); nil != err {
+		return
+	}
+	if "MIT-MAGIC-COOKIE-1" != string(auth[:n]) || !bytes.Equal(x.cookie, auth[(n+3)&^3:(n+3)&^3+d]) {
+		reason := x11Pad([]byte("No protocol specified"))
+		reply := []byte{0, 21, 11, 0, 0, 0}
+		reply = binary.LittleEndian.AppendUint16(reply, uint16(len(reason)/4))
+		_, _ = c.conn.Write(append(reply, reason...))
+		return
+	}
+
+	x.mu.Lock()
+	x.clients++
+	base := uint32(x.clients) << 21
+	x.mu.Unlock()
+	vendor := x11Pad([]byte("fake"))
+	info := x11Body(0, base, 1<<21-1, 0)
+	info = binary.LittleEndian.AppendUint16(info, uint16(len("fake")))
+	info = binary.LittleEndian.AppendUint16(info, x.maxRequest)
+	info = append(info, 1, 0, 0, 0, 32, 32, 8, 255, 0, 0, 0, 0)
+	info = append(info, vendor...)
+	info = append(info, x11Body(1, 0, 0, 0, 0, 0, 0, 0, 0, 0)...) // the screen, root 1
+	reply := []byte{1, 0, 11, 0, 0, 0}
+	reply = binary.LittleEndian.AppendUint16(reply, uint16(len(info)/4))
+	if _, err := c.conn.Write(append(reply, info...)); nil != err {
+		return
+	}
+
+	for {
+		head := make([]byte, 4)
+		if _, err := io.ReadFull(c.conn, head); nil != err {
+			return
+		}
+		body := make([]byte, 4*int(binary.LittleEndian.Uint16(head[2:]))-4)
+		if _, err := io.ReadFull(c.conn, body); nil != err {
+			return
+		}
+		x.mu.Lock()
+		c.seq++
+		x.time++
+		x.request(c, head[0], head[1], body)
+		x.mu.Unlock()
+	}
+}
+
+// card returns the CARD32 at index ix of a request body.
+func card(body []byte, ix int) uint32 {
+	return binary.LittleEndian.Uint32(body[4*ix:])
+}
+
+// write sends a reply, error or event to c.
+func (c *fakeClient) write(packet []byte) {
+	binary.LittleEndian.PutUint16(packet[2:], c.seq)
+	_, _ = c.conn.Write(packet)
+}
+
+// reply sends a reply with the fields after the sequence number.
+func (c *fakeClient) reply(data byte, fields []byte, extra []byte) {
+	packet := []byte{1, data, 0, 0}
+	packet = binary.LittleEndian.AppendUint32(packet, uint32(len(extra)/4))
+	packet = append(packet, fields...)
+	packet = append(packet, make([]byte, 32-len(packet))...)
+	c.write(append(packet, extra...))
+}
+
+// event sends an event, given its fields after the sequence number.
+func (c *fakeClient) event(code byte, fields []byte) {
+	packet := append([]byte{code, 0, 0, 0}, fields...)
+	c.write(append(packet, make([]byte, 32-len(packet))...))
+}
+
+// request carries out one request of c.
+func (x *fakeX) request(c *fakeClient, opcode byte, data byte, body []byte) {
+	const badWindow = 3
+	window := x.windows[card(body, 0)]
+	if nil == window && (x11ChangeWindowAttributes == opcode || x11ChangeProperty == opcode ||
+		x11DeleteProperty == opcode || x11GetProperty == opcode) {
+		c.write([]byte{0, badWindow, 0, 0, 0, 0, 0, 0, 0, 0, opcode, 0, 0, 0, 0, 0,
+			0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0})
+		return
+	}
+
+	switch opcode {
+	case x11CreateWindow:
+		w := &fakeWindow{creator: c, masks: map[*fakeClient]uint32{}, props: map[uint32]fakeProperty{}}
+		if 0 != card(body, 6)&x11CWEventMask {
+			w.masks[c] = card(body, 7)
+		}
+		x.windows[card(body, 0)] = w
+	case x11ChangeWindowAttributes:
+		if 0 != card(body, 1)&x11CWEventMask {
+			window.masks[c] = card(body, 2)
+		}
+	case x11InternAtom:
+		name := string(body[4 : 4+binary.LittleEndian.Uint16(body)])
+		if _, ok := x.atoms[name]; !ok {
+			x.atoms[name] = uint32(100 + len(x.atoms))
+		}
+		c.reply(0, x11Body(x.atoms[name]), nil)
+	case x11ChangeProperty:
+		format := body[12]
+		value := body[20 : 20+int(card(body, 4))*int(format)/8]
+		prop := window.props[card(body, 1)]
+		if x11PropModeAppend != data || 0 == prop.format {
+			prop = fakeProperty{typ: card(body, 2), format: format}
+		}
+		prop.data = append(prop.data, value...)
+		window.props[card(body, 1)] = prop
+		x.propertyNotify(card(body, 0), card(body, 1), 0)
+	case x11DeleteProperty:
+		if _, ok := window.props[card(body, 1)]; ok {
+			delete(window.props, card(body, 1))
+			x.propertyNotify(card(body, 0), card(body, 1), x11PropertyDeleted)
+		}
+	case x11GetProperty:
+		prop, ok := window.props[card(body, 1)]
+		if !ok {
+			c.reply(0, x11Body(0, 0, 0), nil)
+			return
+		}
+		start := min(len(prop.data), 4*int(card(body, 3)))
+		end := min(len(prop.data), start+4*int(card(body, 4)))
+		after := len(prop.data) - end
+		c.reply(prop.format, x11Body(prop.typ, uint32(after), uint32((end-start)*8/int(prop.format))),
+			x11Pad(prop.data[start:end]))
+		if 0 != data && 0 == after {
+			delete(window.props, card(body, 1))
+			x.propertyNotify(card(body, 0), card(body, 1), x11PropertyDeleted)
+		}
+	case x11SetSelectionOwner:
+		selection := card(body, 1)
+		if old, ok := x.owners[selection]; ok && old != card(body, 0) {
+			x.windows[old].creator.event(x11SelectionClear, x11Body(x.time, old, selection))
+		}
+		x.owners[selection] = card(body, 0)
+	case x11GetSelectionOwner:
+		c.reply(0, x11Body(x.owners[card(body, 0)]), nil)
+	case x11ConvertSelection:
+		requestor, selection, target, property, time := card(body, 0), card(body, 1), card(body, 2),
+			card(body, 3), card(body, 4)
+		owner, ok := x.owners[selection]
+		if !ok {
+			x.windows[requestor].creator.event(x11SelectionNotify, x11Body(time, requestor, selection, target, 0))
+			return
+		}
+		x.windows[owner].creator.event(x11SelectionRequest,
+			x11Body(time, owner, requestor, selection, target, property))
+	case x11SendEvent:
+		event := append([]byte(nil), body[8:40]...)
+		event[0] |= 0x80
+		x.windows[card(body, 0)].creator.write(event)
+	default:
+		panic(fmt.Sprintf("huh? fakeX got request %d", opcode))
+	}
+}
+
+// propertyNotify sends PropertyNotify to the clients that asked for it
+// on window.
+func (x *fakeX) propertyNotify(window, property uint32, state byte) {
+	for client, mask := range x.windows[window].masks {
+		if 0 != mask&x11EventMaskPropertyChange {
+			client.event(x11PropertyNotify, append(x11Body(window, property, x.time), state))
+		}
+	}
+}
+
+// own takes the clipboard with text and html on a new connection to
+// display, and returns when it does; done is closed when serveSelection
+// returns.
+func own(t *testing.T, display string, text []byte, html []byte) (done chan error) {
+	t.Helper()
+	x, err := dialX11(display)
+	if nil != err {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = x.Close() })
+	ready := make(chan error, 1)
+	done = make(chan error, 1)
+	go func() { done <- serveSelection(x, text, html, func(err error) { ready <- err }) }()
+	if err := <-ready; nil != err {
+		t.Fatal(err)
+	}
+	return done
+}
+
+// TestX11Clipboard reads back what the x11 backend owns: the text under
+// each text target, the HTML, and both when they are large enough to be
+// sent in chunks.
+func TestX11Clipboard(t *testing.T) {
+	x := startFakeX(t, 4096)
+	clip := x11Clipboard{display: x.display}
+
+	if _, err := clip.Read(clipText); !errors.Is(err, errClipFormat) {
+		t.Errorf("an empty clipboard gave %v", err)
+	}
+
+	large := strings.Repeat("colored ☃ text ", 5000)
+	for _, c := range []struct {
+		name string
+		text string
+		html string
+	}{
+		{"small", "café ☃", "<b>café ☃</b>"},
+		{"large", large, "<b>" + large + "</b>"},
+		{"text only", "plain", ""},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			var html []byte
+			if "" != c.html {
+				html = []byte(c.html)
+			}
+			own(t, x.display, []byte(c.text), html)
+
+			conn, err := dialX11(x.display)
+			if nil != err {
+				t.Fatal(err)
+			}
+			defer func() { _ = conn.Close() }()
+			conn.timeout = x11Timeout
+			for _, target := range x11TextTargets {
+				want := c.text
+				if "STRING" == target {
+					want = strings.ReplaceAll(c.text, "☃", "?")
+				}
+				got, err := readSelection(conn, []string{target})
+				if nil != err || want != string(got) {
+					t.Errorf("%s: %d bytes, %v, want %d", target, len(got), err, len(want))
+				}
+			}
+
+			got, err := clip.Read(clipHTML)
+			switch {
+			case "" == c.html && !errors.Is(err, errClipFormat):
+				t.Errorf("text/html gave %q, %v with no HTML", got, err)
+			case "" != c.html && (nil != err || c.html != string(got)):
+				t.Errorf("text/html: %d bytes, %v, want %d", len(got), err, len(c.html))
+			}
+		})
+	}
+}
+
+// TestX11ClipboardTaken checks that the owner stops serving when another
+// client takes the clipboard.
+func TestX11ClipboardTaken(t *testing.T) {
+	x := startFakeX(t, 4096)
+	done := own(t, x.display, []byte("first"), nil)
+	own(t, x.display, []byte("second"), nil)
+	if err := <-done; nil != err {
+		t.Errorf("the first owner stopped with %v", err)
+	}
+	got, err := x11Clipboard{display: x.display}.Read(clipText)
+	if nil != err || "second" != string(got) {
+		t.Errorf("the clipboard holds %q, %v", got, err)
+	}
+}
+
+// TestX11Auth checks that a display that wants a cookie is refused
+// without the right one, with the reason the server gives.
+func TestX11Auth(t *testing.T) {
+	x := startFakeX(t, 4096)
+	t.Setenv("XAUTHORITY", filepath.Join(t.TempDir(), "none"))
+	_, err := dialX11(x.display)
+	if nil == err || !strings.Contains(err.Error(), "No protocol specified") {
+		t.Errorf("got %v", err)
+	}
+}
+
+// TestX11Write runs the background owner Write starts (the test binary
+// stands in for madcolor, see TestMain) and reads both flavors back.
+func TestX11Write(t *testing.T) {
+	x := startFakeX(t, 4096)
+	clip := x11Clipboard{display: x.display}
+	err := clip.Write([]byte("text"), []byte("<b>html</b>"))
+	if nil != err {
+		t.Fatal(err)
+	}
+	for format, want := range map[clipFormat]string{clipText: "text", clipHTML: "<b>html</b>"} {
+		got, err := clip.Read(format)
+		if nil != err || want != string(got) {
+			t.Errorf("Read(%s) = %q, %v, want %q", format, got, err, want)
+		}
+	}
+}
+
+// TestMain runs the selection owner when the x11 backend starts the test
+// binary as madcolor, and the tests otherwise.
+func TestMain(m *testing.M) {
+	if 1 < len(os.Args) && selectionCommand == os.Args[1] {
+		_ = runSelectionOwner(os.Args[2:])
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}